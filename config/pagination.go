@@ -0,0 +1,21 @@
+package config
+
+import (
+	"os"
+	"strconv"
+)
+
+// GetPaginationMaxLimit mengembalikan batas maksimal item per halaman (PAGINATION_MAX_LIMIT, default 100)
+func GetPaginationMaxLimit() int {
+	value := os.Getenv("PAGINATION_MAX_LIMIT")
+	if value == "" {
+		return 100
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		return 100
+	}
+
+	return limit
+}
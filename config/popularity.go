@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+	"strconv"
+)
+
+// PopularityConfig bobot dan jendela waktu untuk menghitung popularity score music
+type PopularityConfig struct {
+	PlaysWeight     float64
+	LikesWeight     float64
+	PlaylistsWeight float64
+	WindowDays      int
+}
+
+// GetPopularityConfig mengembalikan konfigurasi popularity score dari env
+// (POPULARITY_WEIGHT_PLAYS default 1, POPULARITY_WEIGHT_LIKES default 2,
+// POPULARITY_WEIGHT_PLAYLISTS default 3, POPULARITY_WINDOW_DAYS default 30)
+func GetPopularityConfig() PopularityConfig {
+	return PopularityConfig{
+		PlaysWeight:     getEnvFloat("POPULARITY_WEIGHT_PLAYS", 1),
+		LikesWeight:     getEnvFloat("POPULARITY_WEIGHT_LIKES", 2),
+		PlaylistsWeight: getEnvFloat("POPULARITY_WEIGHT_PLAYLISTS", 3),
+		WindowDays:      getEnvInt("POPULARITY_WINDOW_DAYS", 30),
+	}
+}
+
+// getEnvFloat membaca env sebagai float non-negatif, fallback ke nilai default
+func getEnvFloat(key string, fallback float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil || value < 0 {
+		return fallback
+	}
+	return value
+}
+
+// getEnvInt membaca env sebagai integer positif, fallback ke nilai default
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value < 1 {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,21 @@
+package config
+
+import (
+	"os"
+	"strconv"
+)
+
+// GetSearchTextMaxLength mengembalikan panjang maksimal teks panjang (description/summary) di hasil search (SEARCH_TEXT_MAX_LENGTH, default 200 karakter)
+func GetSearchTextMaxLength() int {
+	value := os.Getenv("SEARCH_TEXT_MAX_LENGTH")
+	if value == "" {
+		return 200
+	}
+
+	length, err := strconv.Atoi(value)
+	if err != nil || length < 1 {
+		return 200
+	}
+
+	return length
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// GetRequestTimeout mengembalikan batas waktu default untuk setiap request (REQUEST_TIMEOUT_SECONDS, default 30 detik)
+func GetRequestTimeout() time.Duration {
+	return getDurationSeconds("REQUEST_TIMEOUT_SECONDS", 30)
+}
+
+// GetUploadRequestTimeout mengembalikan batas waktu untuk route upload (UPLOAD_REQUEST_TIMEOUT_SECONDS, default 600 detik)
+func GetUploadRequestTimeout() time.Duration {
+	return getDurationSeconds("UPLOAD_REQUEST_TIMEOUT_SECONDS", 600)
+}
+
+// getDurationSeconds membaca environment variable dalam satuan detik
+func getDurationSeconds(key string, defaultSeconds int) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return time.Duration(defaultSeconds) * time.Second
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return time.Duration(defaultSeconds) * time.Second
+	}
+
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"backend_soundcave/models"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// BootstrapAdmin membuat akun admin pertama dari BOOTSTRAP_ADMIN_EMAIL dan BOOTSTRAP_ADMIN_PASSWORD.
+// Aman dipanggil setiap startup: tidak melakukan apa-apa jika env tidak di-set atau admin sudah ada.
+func BootstrapAdmin(db *gorm.DB) error {
+	email := strings.ToLower(strings.TrimSpace(os.Getenv("BOOTSTRAP_ADMIN_EMAIL")))
+	password := os.Getenv("BOOTSTRAP_ADMIN_PASSWORD")
+	if email == "" || password == "" {
+		return nil
+	}
+
+	var adminCount int64
+	if err := db.Model(&models.User{}).Where("role = ?", models.RoleAdmin).Count(&adminCount).Error; err != nil {
+		return fmt.Errorf("gagal mengecek admin: %w", err)
+	}
+	if adminCount > 0 {
+		log.Println("Admin sudah ada, bootstrap admin dilewati")
+		return nil
+	}
+
+	// Email yang sudah terdaftar tidak dinaikkan menjadi admin secara otomatis
+	var existing models.User
+	err := db.Unscoped().Where("email = ?", email).First(&existing).Error
+	if err == nil {
+		log.Printf("WARNING: Email %s sudah terdaftar sebagai user biasa, bootstrap admin dilewati", email)
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("gagal mengecek email admin: %w", err)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("gagal hash password admin: %w", err)
+	}
+	hashedPasswordStr := string(hashedPassword)
+
+	fullName := strings.TrimSpace(os.Getenv("BOOTSTRAP_ADMIN_NAME"))
+	if fullName == "" {
+		fullName = "Administrator"
+	}
+
+	admin := models.User{
+		FullName: fullName,
+		Email:    email,
+		Password: &hashedPasswordStr,
+		Role:     models.RoleAdmin,
+	}
+	if err := db.Create(&admin).Error; err != nil {
+		return fmt.Errorf("gagal membuat admin: %w", err)
+	}
+
+	log.Printf("WARNING: Akun admin bootstrap dibuat untuk %s. Segera ganti password dan hapus BOOTSTRAP_ADMIN_PASSWORD dari environment", email)
+	return nil
+}
@@ -0,0 +1,73 @@
+package database_test
+
+import (
+	"testing"
+
+	"backend_soundcave/database"
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestBootstrapAdminCreatesAdminOnce(t *testing.T) {
+	db := testdb.New(t)
+	t.Setenv("BOOTSTRAP_ADMIN_EMAIL", " Admin@Example.com ")
+	t.Setenv("BOOTSTRAP_ADMIN_PASSWORD", "secret-password")
+	t.Setenv("BOOTSTRAP_ADMIN_NAME", "")
+
+	for i := 0; i < 2; i++ {
+		if err := database.BootstrapAdmin(db); err != nil {
+			t.Fatalf("BootstrapAdmin run %d error = %v", i+1, err)
+		}
+	}
+
+	var admins []models.User
+	db.Where("role = ?", models.RoleAdmin).Find(&admins)
+	if len(admins) != 1 {
+		t.Fatalf("got %d admins, want 1", len(admins))
+	}
+	admin := admins[0]
+	if admin.Email != "admin@example.com" || admin.FullName != "Administrator" {
+		t.Errorf("admin = %s <%s>, want Administrator <admin@example.com>", admin.FullName, admin.Email)
+	}
+	if admin.Password == nil || bcrypt.CompareHashAndPassword([]byte(*admin.Password), []byte("secret-password")) != nil {
+		t.Error("admin password is not the bcrypt hash of BOOTSTRAP_ADMIN_PASSWORD")
+	}
+}
+
+func TestBootstrapAdminSkipsWhenAdminExists(t *testing.T) {
+	db := testdb.New(t)
+	existing := models.User{FullName: "Existing", Email: "existing@example.com", Role: models.RoleAdmin}
+	if err := db.Create(&existing).Error; err != nil {
+		t.Fatalf("gagal seed admin: %v", err)
+	}
+
+	t.Setenv("BOOTSTRAP_ADMIN_EMAIL", "admin@example.com")
+	t.Setenv("BOOTSTRAP_ADMIN_PASSWORD", "secret-password")
+	if err := database.BootstrapAdmin(db); err != nil {
+		t.Fatalf("BootstrapAdmin error = %v", err)
+	}
+
+	var total int64
+	db.Model(&models.User{}).Count(&total)
+	if total != 1 {
+		t.Errorf("got %d users, want only the existing admin", total)
+	}
+}
+
+func TestBootstrapAdminSkipsWithoutEnv(t *testing.T) {
+	db := testdb.New(t)
+	t.Setenv("BOOTSTRAP_ADMIN_EMAIL", "")
+	t.Setenv("BOOTSTRAP_ADMIN_PASSWORD", "")
+
+	if err := database.BootstrapAdmin(db); err != nil {
+		t.Fatalf("BootstrapAdmin error = %v", err)
+	}
+
+	var total int64
+	db.Model(&models.User{}).Count(&total)
+	if total != 0 {
+		t.Errorf("got %d users, want none", total)
+	}
+}
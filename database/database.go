@@ -55,28 +55,60 @@ func Connect() (*gorm.DB, error) {
 	}
 
 	// Auto migrate models
-	err = db.AutoMigrate(
+	if err := Migrate(db); err != nil {
+		return nil, err
+	}
+
+	DB = db
+	return db, nil
+}
+
+// Migrate menjalankan auto migrate untuk semua model
+func Migrate(db *gorm.DB) error {
+	err := db.AutoMigrate(
 		&models.Image{},
+		&models.UploadedFile{},
 		&models.User{},
 		&models.Album{},
 		&models.AppInfo{},
 		&models.Artist{},
 		&models.Genre{},
 		&models.Music{},
+		&models.MusicLike{},
+		&models.MusicGenre{},
+		&models.PlayEvent{},
 		&models.MusicVideo{},
 		&models.Notification{},
+		&models.NotificationPreference{},
 		&models.Playlist{},
 		&models.PlaylistSong{},
+		&models.PlaylistCollaborator{},
 		&models.Podcast{},
 		&models.SubscriptionPlan{},
 		&models.News{},
 		&models.Cavelist{},
+		&models.CavelistEvent{},
 		&models.ArtistStream{},
+		&models.AuditLog{},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("gagal migrate database: %w", err)
+		return fmt.Errorf("gagal migrate database: %w", err)
 	}
 
-	DB = db
-	return db, nil
+	if err := backfillMusicGenres(db); err != nil {
+		return fmt.Errorf("gagal backfill music_genres: %w", err)
+	}
+
+	return nil
+}
+
+// backfillMusicGenres membuat baris music_genres dari kolom genre untuk music yang belum punya relasi genre.
+// Aman dijalankan setiap startup karena music yang sudah punya baris music_genres dilewati.
+func backfillMusicGenres(db *gorm.DB) error {
+	return db.Exec(`INSERT INTO music_genres (music_id, genre_id, created_at)
+		SELECT musics.id, genres.id, NOW()
+		FROM musics
+		JOIN genres ON LOWER(genres.name) = LOWER(TRIM(musics.genre)) AND genres.deleted_at IS NULL
+		WHERE musics.deleted_at IS NULL
+		AND NOT EXISTS (SELECT 1 FROM music_genres WHERE music_genres.music_id = musics.id)`).Error
 }
@@ -0,0 +1,47 @@
+package database_test
+
+import (
+	"testing"
+
+	"backend_soundcave/database"
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+)
+
+func TestMigrateBackfillsMusicGenres(t *testing.T) {
+	db := testdb.New(t)
+
+	rock := models.Genre{Name: "Rock", Description: "Rock"}
+	jazz := models.Genre{Name: "Jazz", Description: "Jazz"}
+	db.Create(&rock)
+	db.Create(&jazz)
+
+	musics := []models.Music{
+		{Title: "Matched", Artist: "A", ArtistID: 1, Genre: " rock ", Duration: "03:00", Language: "Indonesian", AudioFileURL: "a.mp3"},
+		{Title: "Unknown", Artist: "A", ArtistID: 1, Genre: "Polka", Duration: "03:00", Language: "Indonesian", AudioFileURL: "b.mp3"},
+		{Title: "Assigned", Artist: "A", ArtistID: 1, Genre: "Rock", Duration: "03:00", Language: "Indonesian", AudioFileURL: "c.mp3"},
+	}
+	if err := db.Create(&musics).Error; err != nil {
+		t.Fatal(err)
+	}
+	// Music yang sudah punya music_genres tidak disentuh
+	db.Create(&models.MusicGenre{MusicID: musics[2].ID, GenreID: jazz.ID})
+
+	// Migrate dijalankan ulang seperti saat startup berikutnya
+	if err := database.Migrate(db); err != nil {
+		t.Fatalf("Migrate error = %v", err)
+	}
+
+	want := map[uint][]uint{
+		musics[0].ID: {rock.ID},
+		musics[1].ID: nil,
+		musics[2].ID: {jazz.ID},
+	}
+	for musicID, wantIDs := range want {
+		var got []uint
+		db.Model(&models.MusicGenre{}).Where("music_id = ?", musicID).Pluck("genre_id", &got)
+		if len(got) != len(wantIDs) || (len(got) > 0 && got[0] != wantIDs[0]) {
+			t.Errorf("music %d genres = %v, want %v", musicID, got, wantIDs)
+		}
+	}
+}
@@ -23,14 +23,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/albums": {
-            "get": {
+        "/admin/genres/{id}/editorial-weight": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of albums with filtering and search",
+                "description": "Set the editorial weight added to every music of a genre under ?sort=editorial (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -38,51 +38,91 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Albums"
+                    "Admin"
                 ],
-                "summary": "Get all albums",
+                "summary": "Update genre editorial weight",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Genre ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Editorial weight",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateEditorialWeightRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Filter by artist",
-                        "name": "artist",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Search by title or artist",
-                        "name": "search",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
-                    {
-                        "type": "string",
-                        "default": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/musics/duplicates": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Sort order",
-                        "name": "order",
-                        "in": "query"
+                        "BearerAuth": []
                     }
                 ],
+                "description": "Group musics that share the same normalized title, artist and duration (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get duplicate musics",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -98,6 +138,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -106,95 +153,43 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/musics/genres": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new album",
+                "description": "Replace the genres of many musics at once (admin only). For each valid row the music_genres rows of the music are replaced and the first genre becomes the music's primary genre. All changes run in one transaction. Each row reports updated, not_found or invalid",
                 "consumes": [
-                    "application/json",
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Albums"
+                    "Admin"
                 ],
-                "summary": "Create new album",
+                "summary": "Bulk assign music genres",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Album Title",
-                        "name": "title",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Artist ID",
-                        "name": "artist_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Artist Name",
-                        "name": "artist",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Release Date (YYYY-MM-DD)",
-                        "name": "release_date",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Album Type (single, EP, album, compilation)",
-                        "name": "album_type",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Genre",
-                        "name": "genre",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Total Tracks",
-                        "name": "total_tracks",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Record Label",
-                        "name": "record_label",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "file",
-                        "description": "Album cover image file",
-                        "name": "image",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Album cover image URL (if not uploading file)",
-                        "name": "image_url",
-                        "in": "formData"
+                        "description": "Genres per music",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.AssignMusicGenresRequest"
+                            }
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -214,6 +209,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -224,14 +226,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/albums/{id}": {
-            "get": {
+        "/admin/musics/{id}/editorial-weight": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get album details by ID",
+                "description": "Set the editorial weight used to boost a music track under ?sort=editorial (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -239,16 +241,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Albums"
+                    "Admin"
                 ],
-                "summary": "Get album by ID",
+                "summary": "Update music editorial weight",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Album ID",
+                        "description": "Music ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Editorial weight",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateEditorialWeightRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -259,6 +270,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -266,6 +284,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -281,92 +306,2433 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/admin/musics/{id}/merge/{into_id}": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update album information",
+                "description": "Repoint playlist songs, likes, play events, genres, linked versions and artist pins from a duplicate music to the surviving one, combine the counters and soft delete the duplicate (admin only)",
                 "consumes": [
-                    "application/json",
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Albums"
+                    "Admin"
                 ],
-                "summary": "Update album",
+                "summary": "Merge duplicate music",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Album ID",
+                        "description": "Duplicate Music ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "Album Title",
-                        "name": "title",
-                        "in": "formData"
-                    },
                     {
                         "type": "integer",
-                        "description": "Artist ID",
-                        "name": "artist_id",
-                        "in": "formData"
+                        "description": "Surviving Music ID",
+                        "name": "into_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
-                    {
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/musics/{id}/reset-counts": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reset play_count and like_count of a music track to zero. Optionally clear its play events, and its likes so like_count stays in sync with music_likes (this removes the track from listeners' liked songs). The action is recorded in the audit log (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Reset music counts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Music ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also delete recorded play events",
+                        "name": "clear_events",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also delete users' likes of the track",
+                        "name": "clear_likes",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/playlists/{id}/integrity": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report duplicate, missing and out of range song positions of a playlist. Positions are expected to be contiguous 0..n-1 (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Check playlist positions integrity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/playlists/{id}/integrity/normalize": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rewrite song positions of a playlist to contiguous 0..n-1, keeping the current order (ties broken by ID) (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Normalize playlist positions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/revoke-sessions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invalidate every token previously issued to a user so they must log in again. The password is not changed (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Revoke user sessions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/albums": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get paginated list of albums with filtering and search",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Albums"
+                ],
+                "summary": "Get all albums",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by artist",
+                        "name": "artist",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by title or artist",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new album",
+                "consumes": [
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Albums"
+                ],
+                "summary": "Create new album",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Album Title",
+                        "name": "title",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "artist_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Artist Name",
+                        "name": "artist",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Release Date (YYYY-MM-DD)",
+                        "name": "release_date",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Album Type (single, EP, album, compilation)",
+                        "name": "album_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Genre",
+                        "name": "genre",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Total Tracks",
+                        "name": "total_tracks",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record Label",
+                        "name": "record_label",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Album cover image file",
+                        "name": "image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Album cover image URL (if not uploading file)",
+                        "name": "image_url",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/albums/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get album details by ID including its artist. Admins can pass include_deleted_relations=true to also resolve a soft deleted artist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Albums"
+                ],
+                "summary": "Get album by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Album ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include soft deleted related rows (admin only)",
+                        "name": "include_deleted_relations",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update album information",
+                "consumes": [
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Albums"
+                ],
+                "summary": "Update album",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Album ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Album Title",
+                        "name": "title",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "artist_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Artist Name",
+                        "name": "artist",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Release Date (YYYY-MM-DD)",
+                        "name": "release_date",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Album Type (single, EP, album, compilation)",
+                        "name": "album_type",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Genre",
+                        "name": "genre",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Total Tracks",
+                        "name": "total_tracks",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record Label",
+                        "name": "record_label",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Album cover image file",
+                        "name": "image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Album cover image URL (if not uploading file)",
+                        "name": "image_url",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete an album by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Albums"
+                ],
+                "summary": "Delete album",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Album ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/app-info": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get paginated list of app information entries",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AppInfo"
+                ],
+                "summary": "Get all app info",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by app name or tagline",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new app information entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AppInfo"
+                ],
+                "summary": "Create new app info",
+                "parameters": [
+                    {
+                        "description": "App Info Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateAppInfoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/app-info/latest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the latest app information entry. Supports conditional requests via If-None-Match (ETag); Last-Modified is informational and If-Modified-Since is ignored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AppInfo"
+                ],
+                "summary": "Get latest app info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag from a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/app-info/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get app information details by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AppInfo"
+                ],
+                "summary": "Get app info by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "App Info ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update app information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AppInfo"
+                ],
+                "summary": "Update app info",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "App Info ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update App Info Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateAppInfoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete an app information entry by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AppInfo"
+                ],
+                "summary": "Delete app info",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "App Info ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artist-streams/active": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of currently active live streams",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ArtistStreams"
+                ],
+                "summary": "Get active streams",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artist-streams/end/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "End an active live stream for an artist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ArtistStreams"
+                ],
+                "summary": "End live stream",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Stream ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artist-streams/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get history of artist streams with pagination and filters for status and artist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ArtistStreams"
+                ],
+                "summary": "Get artist stream history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Stream status filter (scheduled, live, ended)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Artist ID filter",
+                        "name": "artist_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artist-streams/start": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Start a new live stream for an artist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ArtistStreams"
+                ],
+                "summary": "Start live stream",
+                "parameters": [
+                    {
+                        "description": "Start Stream Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.StartStreamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artist-streams/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get details of a specific live stream",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ArtistStreams"
+                ],
+                "summary": "Get stream details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Stream ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artist-streams/{id}/mark-live": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark a pending stream as live after successful WHIP/WebRTC connection. Called by the frontend immediately after WHIP broadcast starts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ArtistStreams"
+                ],
+                "summary": "Mark stream as live",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Stream ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artists": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get paginated list of artists with filtering and search",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Get all artists",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by highlight status (0 or 1)",
+                        "name": "is_highlight",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new artist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Create new artist",
+                "parameters": [
+                    {
+                        "description": "Artist Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateArtistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bulk create artists (label or admin only). Rows are validated, deduplicated on email and normalized name against existing artists and earlier rows, and valid new rows are inserted in one transaction. Each row reports created, duplicate or invalid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Import artists",
+                "parameters": [
+                    {
+                        "description": "Artists to import",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.CreateArtistRequest"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/random": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a list of random artists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Get random artists",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of artists to return",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get artist details by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Get artist by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update artist information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Update artist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Artist Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateArtistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete an artist by ID. Also removes associated user if ref_user_id is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Delete artist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{id}/follow": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Follow an artist and increment their follower count",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Follow artist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{id}/highlight": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set an artist as highlighted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Highlight artist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{id}/musics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get paginated musics of an artist. Supports the same filters and search as the musics list; the pinned track is listed first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Get artist musics",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page (max PAGINATION_MAX_LIMIT)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Skip total count and return has_more only",
+                        "name": "skip_total",
+                        "in": "query"
+                    },
+                    {
                         "type": "string",
-                        "description": "Artist Name",
-                        "name": "artist",
-                        "in": "formData"
+                        "description": "Filter by genre",
+                        "name": "genre",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by genre ID (any of the music's assigned genres)",
+                        "name": "genre_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by album ID",
+                        "name": "album_id",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Release Date (YYYY-MM-DD)",
-                        "name": "release_date",
-                        "in": "formData"
+                        "description": "Search by title, artist, or album. Search results are trimmed and omit lyrics",
+                        "name": "search",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Album Type (single, EP, album, compilation)",
-                        "name": "album_type",
-                        "in": "formData"
+                        "description": "Include omitted fields in search results (lyrics)",
+                        "name": "expand",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Genre",
-                        "name": "genre",
-                        "in": "formData"
+                        "description": "Use editorial to rank by editorial weight of the music and its genre, then play count",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Total Tracks",
-                        "name": "total_tracks",
-                        "in": "formData"
+                        "description": "Filter by approval status (0 or 1)",
+                        "name": "is_approved",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Record Label",
-                        "name": "record_label",
-                        "in": "formData"
+                        "description": "Filter by submitted_by (artist, label, admin)",
+                        "name": "submitted_by",
+                        "in": "query"
                     },
                     {
-                        "type": "file",
-                        "description": "Album cover image file",
-                        "name": "image",
-                        "in": "formData"
+                        "type": "boolean",
+                        "description": "Swap explicit tracks to their clean version when available",
+                        "name": "safe",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{id}/pin": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pin one of the artist's own tracks to the top of the artist page (owner or admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Pin music on artist page",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pin Music Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PinArtistMusicRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the pinned track from the artist page (owner or admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artists"
+                ],
+                "summary": "Unpin music from artist page",
+                "parameters": [
                     {
-                        "type": "string",
-                        "description": "Album cover image URL (if not uploading file)",
-                        "name": "image_url",
-                        "in": "formData"
+                        "type": "integer",
+                        "description": "Artist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -377,15 +2743,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -406,14 +2772,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/artists/{id}/release-years": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete an album by ID",
+                "description": "Get distinct years in which an artist released musics or albums, with counts per year (newest first)",
                 "consumes": [
                     "application/json"
                 ],
@@ -421,13 +2789,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Albums"
+                    "Artists"
                 ],
-                "summary": "Delete album",
+                "summary": "Get artist release years",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Album ID",
+                        "description": "Artist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -465,14 +2833,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/app-info": {
-            "get": {
+        "/artists/{id}/unfollow": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of app information entries",
+                "description": "Unfollow an artist and decrement their follower count",
                 "consumes": [
                     "application/json"
                 ],
@@ -480,43 +2848,79 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AppInfo"
+                    "Artists"
                 ],
-                "summary": "Get all app info",
+                "summary": "Unfollow artist",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Artist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Search by app name or tagline",
-                        "name": "search",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
-                    {
-                        "type": "string",
-                        "default": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/google": {
+            "post": {
+                "description": "Login or register using Google ID token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Google authentication",
+                "parameters": [
                     {
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Sort order",
-                        "name": "order",
-                        "in": "query"
+                        "description": "Google Auth Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GoogleAuthRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -527,6 +2931,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -542,14 +2953,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/login": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a new app information entry",
+                "description": "Login with email and password to get JWT token",
                 "consumes": [
                     "application/json"
                 ],
@@ -557,23 +2965,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AppInfo"
+                    "Auth"
                 ],
-                "summary": "Create new app info",
+                "summary": "User login",
                 "parameters": [
                     {
-                        "description": "App Info Request",
+                        "description": "Login Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateAppInfoRequest"
+                            "$ref": "#/definitions/handlers.LoginRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -603,14 +3011,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/app-info/latest": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get the latest app information entry",
+        "/auth/register": {
+            "post": {
+                "description": "Register a new user account. Role defaults to \"user\" if not specified. Allowed roles: user, admin, premium, independent, label",
                 "consumes": [
                     "application/json"
                 ],
@@ -618,26 +3021,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AppInfo"
+                    "Auth"
+                ],
+                "summary": "Register new user",
+                "parameters": [
+                    {
+                        "description": "Register Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RegisterRequest"
+                        }
+                    }
                 ],
-                "summary": "Get latest app info",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -653,14 +3067,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/app-info/{id}": {
+        "/cavelists": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get app information details by ID",
+                "description": "Get paginated list of cavelists with filtering and search",
                 "consumes": [
                     "application/json"
                 ],
@@ -668,16 +3082,61 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AppInfo"
+                    "Cavelists"
                 ],
-                "summary": "Get app info by ID",
+                "summary": "Get all cavelists",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "App Info ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by artist_id",
+                        "name": "artist_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (draft/publish)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by is_promotion",
+                        "name": "is_promotion",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by title or description",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -688,15 +3147,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -711,13 +3170,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update app information",
+                "description": "Create a new cavelist",
                 "consumes": [
                     "application/json"
                 ],
@@ -725,30 +3184,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "AppInfo"
+                    "Cavelists"
                 ],
-                "summary": "Update app info",
+                "summary": "Create new cavelist",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "App Info ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update App Info Request",
+                        "description": "Cavelist Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateAppInfoRequest"
+                            "$ref": "#/definitions/handlers.CreateCavelistRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -768,13 +3220,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -783,31 +3228,39 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/cavelists/upload": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete an app information entry by ID",
+                "description": "Upload a cavelist video file to Firebase Storage (max 150MB)",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "AppInfo"
+                    "Cavelists"
                 ],
-                "summary": "Delete app info",
+                "summary": "Upload cavelist video file",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "App Info ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "Video file (MP4, MOV, AVI, WMV, WebM, MKV, 3GP)",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Folder path (default: cavelist-videos)",
+                        "name": "folder",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -818,15 +3271,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -842,14 +3295,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artist-streams/active": {
+        "/cavelists/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get list of currently active live streams",
+                "description": "Get a single cavelist by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -857,23 +3310,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ArtistStreams"
+                    "Cavelists"
                 ],
-                "summary": "Get active streams",
+                "summary": "Get cavelist by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Cavelist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -884,6 +3330,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -892,16 +3352,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/artist-streams/end/{id}": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "End an active live stream for an artist",
+                "description": "Update cavelist information",
                 "consumes": [
                     "application/json"
                 ],
@@ -909,16 +3367,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ArtistStreams"
+                    "Cavelists"
                 ],
-                "summary": "End live stream",
+                "summary": "Update cavelist",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Stream ID",
+                        "description": "Cavelist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update Cavelist Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateCavelistRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -929,8 +3396,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -951,16 +3425,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/artist-streams/history": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get history of artist streams with pagination and filters for status and artist",
+                "description": "Soft delete a cavelist by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -968,35 +3440,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ArtistStreams"
+                    "Cavelists"
                 ],
-                "summary": "Get artist stream history",
+                "summary": "Delete cavelist",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Stream status filter (scheduled, live, ended)",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Artist ID filter",
-                        "name": "artist_id",
-                        "in": "query"
+                        "description": "Cavelist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1007,8 +3460,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1024,14 +3484,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artist-streams/start": {
+        "/cavelists/{id}/like": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Start a new live stream for an artist",
+                "description": "Increment likes count for a cavelist",
                 "consumes": [
                     "application/json"
                 ],
@@ -1039,37 +3499,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ArtistStreams"
+                    "Cavelists"
                 ],
-                "summary": "Start live stream",
+                "summary": "Increment cavelist likes",
                 "parameters": [
                     {
-                        "description": "Start Stream Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.StartStreamRequest"
-                        }
+                        "type": "integer",
+                        "description": "Cavelist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1085,14 +3543,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artist-streams/{id}": {
-            "get": {
+        "/cavelists/{id}/share": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get details of a specific live stream",
+                "description": "Increment shares count for a cavelist",
                 "consumes": [
                     "application/json"
                 ],
@@ -1100,13 +3558,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ArtistStreams"
+                    "Cavelists"
                 ],
-                "summary": "Get stream details",
+                "summary": "Increment cavelist shares",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Stream ID",
+                        "description": "Cavelist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1120,6 +3578,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1137,14 +3602,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists": {
+        "/cavelists/{id}/stats": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of artists with filtering and search",
+                "description": "Get daily views, likes and shares of a cavelist for the last N days",
                 "consumes": [
                     "application/json"
                 ],
@@ -1152,48 +3617,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Artists"
+                    "Cavelists"
                 ],
-                "summary": "Get all artists",
+                "summary": "Get cavelist stats",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by name",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Sort order",
-                        "name": "order",
-                        "in": "query"
+                        "description": "Cavelist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "Filter by highlight status (0 or 1)",
-                        "name": "is_highlight",
+                        "default": 30,
+                        "description": "Number of days (max 365)",
+                        "name": "days",
                         "in": "query"
                     }
                 ],
@@ -1205,6 +3644,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -1212,6 +3658,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1220,14 +3673,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/dashboard/artist-stats": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new artist",
+                "description": "Get dashboard statistics for independent artist or label users including album, song, and music video counts",
                 "consumes": [
                     "application/json"
                 ],
@@ -1235,37 +3690,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Artists"
-                ],
-                "summary": "Create new artist",
-                "parameters": [
-                    {
-                        "description": "Artist Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateArtistRequest"
-                        }
-                    }
+                    "Dashboard"
                 ],
+                "summary": "Get artist dashboard statistics",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1281,14 +3725,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/random": {
+        "/dashboard/customer-report": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a list of random artists",
+                "description": "Get customer report with revenue, growth, and analytics",
                 "consumes": [
                     "application/json"
                 ],
@@ -1296,15 +3740,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Artists"
+                    "Dashboard"
                 ],
-                "summary": "Get random artists",
+                "summary": "Get customer report",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 10,
-                        "description": "Number of artists to return",
-                        "name": "limit",
+                        "default": 30,
+                        "description": "Report period in days",
+                        "name": "period",
                         "in": "query"
                     }
                 ],
@@ -1333,14 +3777,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/{id}": {
+        "/dashboard/listener-demographics": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get artist details by ID",
+                "description": "Get plays and unique listeners per country for the tracks of the artists linked to the caller (ref_user_id) in the last N days. Country is derived from the listener's profile location",
                 "consumes": [
                     "application/json"
                 ],
@@ -1348,16 +3792,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Artists"
+                    "Dashboard"
                 ],
-                "summary": "Get artist by ID",
+                "summary": "Get listener demographics",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Artist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 30,
+                        "description": "Number of days (max 365)",
+                        "name": "days",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1368,6 +3812,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -1375,8 +3826,8 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1390,14 +3841,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/dashboard/stats": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update artist information",
+                "description": "Get dashboard statistics including counts and metrics",
                 "consumes": [
                     "application/json"
                 ],
@@ -1405,27 +3858,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Artists"
-                ],
-                "summary": "Update artist",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Artist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update Artist Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateArtistRequest"
-                        }
-                    }
+                    "Dashboard"
                 ],
+                "summary": "Get dashboard statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1434,13 +3869,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -1448,13 +3876,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1463,14 +3884,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/dashboard/storage-usage": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete an artist by ID. Also removes associated user if ref_user_id is set.",
+                "description": "Get the storage footprint of the caller: uploaded images plus audio and video files of the artists linked to the user (ref_user_id)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1478,18 +3901,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Artists"
-                ],
-                "summary": "Delete artist",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Artist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Dashboard"
                 ],
+                "summary": "Get storage usage",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1505,8 +3919,8 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1522,14 +3936,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/{id}/follow": {
-            "post": {
+        "/genres": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Follow an artist and increment their follower count",
+                "description": "Get paginated list of genres with filtering and search",
                 "consumes": [
                     "application/json"
                 ],
@@ -1537,16 +3951,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Artists"
+                    "Genres"
                 ],
-                "summary": "Follow artist",
+                "summary": "Get all genres",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Artist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1557,29 +3998,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1593,16 +4020,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/artists/{id}/highlight": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Set an artist as highlighted",
+                "description": "Create a new music genre",
                 "consumes": [
                     "application/json"
                 ],
@@ -1610,35 +4035,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Artists"
+                    "Genres"
                 ],
-                "summary": "Highlight artist",
+                "summary": "Create new genre",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Artist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Genre Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateGenreRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1654,14 +4081,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/{id}/unfollow": {
-            "post": {
+        "/genres/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Unfollow an artist and decrement their follower count",
+                "description": "Get genre details by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1669,13 +4096,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Artists"
+                    "Genres"
                 ],
-                "summary": "Unfollow artist",
+                "summary": "Get genre by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Artist ID",
+                        "description": "Genre ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1689,13 +4116,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -1718,11 +4138,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/google": {
-            "post": {
-                "description": "Login or register using Google ID token",
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update genre information",
                 "consumes": [
                     "application/json"
                 ],
@@ -1730,17 +4153,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Genres"
                 ],
-                "summary": "Google authentication",
+                "summary": "Update genre",
                 "parameters": [
                     {
-                        "description": "Google Auth Request",
+                        "type": "integer",
+                        "description": "Genre ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Genre Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.GoogleAuthRequest"
+                            "$ref": "#/definitions/handlers.UpdateGenreRequest"
                         }
                     }
                 ],
@@ -1766,6 +4196,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1774,11 +4211,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/login": {
-            "post": {
-                "description": "Login with email and password to get JWT token",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a genre by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1786,18 +4226,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Genres"
                 ],
-                "summary": "User login",
+                "summary": "Delete genre",
                 "parameters": [
                     {
-                        "description": "Login Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.LoginRequest"
-                        }
+                        "type": "integer",
+                        "description": "Genre ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1808,15 +4246,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1832,9 +4270,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/register": {
-            "post": {
-                "description": "Register a new user account. Role defaults to \"user\" if not specified. Allowed roles: user, admin, premium, independent, label",
+        "/images": {
+            "get": {
+                "description": "Get paginated list of uploaded images",
                 "consumes": [
                     "application/json"
                 ],
@@ -1842,37 +4280,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Images"
                 ],
-                "summary": "Register new user",
+                "summary": "Get all images",
                 "parameters": [
                     {
-                        "description": "Register Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.RegisterRequest"
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1888,76 +4317,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/cavelists": {
-            "get": {
+        "/images/multiple": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of cavelists with filtering and search",
+                "description": "Upload multiple image files to Firebase Storage",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cavelists"
+                    "Images"
                 ],
-                "summary": "Get all cavelists",
+                "summary": "Upload multiple images",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Filter by artist_id",
-                        "name": "artist_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by status (draft/publish)",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Filter by is_promotion",
-                        "name": "is_promotion",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by title or description",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
+                        "type": "file",
+                        "description": "Image files",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "default": "desc",
-                        "description": "Sort order",
-                        "name": "order",
-                        "in": "query"
+                        "description": "Folder path (default: images)",
+                        "name": "folder",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -1968,6 +4358,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -1983,38 +4380,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/images/upload": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new cavelist",
+                "description": "Upload a single image file to Firebase Storage",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cavelists"
+                    "Images"
                 ],
-                "summary": "Create new cavelist",
+                "summary": "Upload image",
                 "parameters": [
                     {
-                        "description": "Cavelist Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateCavelistRequest"
-                        }
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Folder path (default: images)",
+                        "name": "folder",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -2044,37 +4447,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/cavelists/upload": {
-            "post": {
+        "/images/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload a cavelist video file to Firebase Storage (max 150MB)",
+                "description": "Delete an image by ID",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cavelists"
+                    "Images"
                 ],
-                "summary": "Upload cavelist video file",
+                "summary": "Delete image",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Video file (MP4, MOV, AVI, WMV, WebM, MKV, 3GP)",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Image ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Folder path (default: cavelist-videos)",
-                        "name": "folder",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -2085,15 +4482,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -2109,14 +4506,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cavelists/{id}": {
+        "/music-videos": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single cavelist by ID",
+                "description": "Get paginated list of music videos with filtering and search",
                 "consumes": [
                     "application/json"
                 ],
@@ -2124,16 +4521,73 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cavelists"
+                    "MusicVideos"
                 ],
-                "summary": "Get cavelist by ID",
+                "summary": "Get all music videos",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Cavelist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by artist ID",
+                        "name": "artist_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by genre",
+                        "name": "genre",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by title or artist",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by approval status (0, 1, or 2)",
+                        "name": "is_approved",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by highlight status (0 or 1)",
+                        "name": "is_highlight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by submitter (artist, label, admin)",
+                        "name": "submitted_by",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2144,15 +4598,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -2167,13 +4621,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update cavelist information",
+                "description": "Create a new music video",
                 "consumes": [
                     "application/json"
                 ],
@@ -2181,30 +4635,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cavelists"
+                    "MusicVideos"
                 ],
-                "summary": "Update cavelist",
+                "summary": "Create new music video",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Cavelist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update Cavelist Request",
+                        "description": "Music Video Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateCavelistRequest"
+                            "$ref": "#/definitions/handlers.CreateMusicVideoRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -2224,13 +4671,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2239,31 +4679,39 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/music-videos/upload": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a cavelist by ID",
+                "description": "Upload a music video file to Firebase Storage (max 150MB)",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cavelists"
+                    "MusicVideos"
                 ],
-                "summary": "Delete cavelist",
+                "summary": "Upload music video file",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Cavelist ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "Video file (MP4, MOV, AVI, WMV, WebM, MKV, 3GP)",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Folder path (default: music-videos)",
+                        "name": "folder",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -2274,15 +4722,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -2298,14 +4746,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cavelists/{id}/like": {
-            "post": {
+        "/music-videos/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Increment likes count for a cavelist",
+                "description": "Get music video details by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2313,13 +4761,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cavelists"
+                    "MusicVideos"
                 ],
-                "summary": "Increment cavelist likes",
+                "summary": "Get music video by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Cavelist ID",
+                        "description": "Music Video ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2355,16 +4803,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/cavelists/{id}/share": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Increment shares count for a cavelist",
+                "description": "Update music video information",
                 "consumes": [
                     "application/json"
                 ],
@@ -2372,16 +4818,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cavelists"
+                    "MusicVideos"
                 ],
-                "summary": "Increment cavelist shares",
+                "summary": "Update music video",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Cavelist ID",
+                        "description": "Music Video ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update Music Video Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateMusicVideoRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2392,6 +4847,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -2414,16 +4876,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/dashboard/artist-stats": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get dashboard statistics for independent artist or label users including album, song, and music video counts",
+                "description": "Soft delete a music video by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2431,9 +4891,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard"
+                    "MusicVideos"
+                ],
+                "summary": "Delete music video",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Music Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get artist dashboard statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2449,8 +4918,8 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -2466,14 +4935,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/customer-report": {
-            "get": {
+        "/music-videos/{id}/approve": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get customer report with revenue, growth, and analytics",
+                "description": "Approve a music video by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2481,16 +4950,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard"
+                    "MusicVideos"
                 ],
-                "summary": "Get customer report",
+                "summary": "Approve music video",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 30,
-                        "description": "Report period in days",
-                        "name": "period",
-                        "in": "query"
+                        "description": "Music Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Approve Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ApproveMusicVideoRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2501,6 +4979,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -2508,6 +4993,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2518,14 +5010,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/stats": {
-            "get": {
+        "/music-videos/{id}/stream": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get dashboard statistics including counts and metrics",
+                "description": "Increment total stream count for a music video",
                 "consumes": [
                     "application/json"
                 ],
@@ -2533,9 +5025,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard"
+                    "MusicVideos"
+                ],
+                "summary": "Increment stream count",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Music Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get dashboard statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2551,6 +5052,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2561,14 +5069,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/genres": {
+        "/musics": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of genres with filtering and search",
+                "description": "Get paginated list of musics with filtering and search",
                 "consumes": [
                     "application/json"
                 ],
@@ -2576,9 +5084,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Genres"
+                    "Musics"
                 ],
-                "summary": "Get all genres",
+                "summary": "Get all musics",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2590,16 +5098,59 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 10,
-                        "description": "Items per page",
+                        "description": "Items per page (max PAGINATION_MAX_LIMIT)",
                         "name": "limit",
                         "in": "query"
                     },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Skip total count and return has_more only",
+                        "name": "skip_total",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Search by name",
+                        "description": "Filter by artist",
+                        "name": "artist",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by genre",
+                        "name": "genre",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by genre ID (any of the music's assigned genres)",
+                        "name": "genre_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by album ID",
+                        "name": "album_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by title, artist, or album. Search results are trimmed and omit lyrics",
                         "name": "search",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Include omitted fields in search results (lyrics)",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Use editorial to rank by editorial weight of the music and its genre, then play count",
+                        "name": "sort",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "default": "created_at",
@@ -2613,117 +5164,30 @@ const docTemplate = `{
                         "description": "Sort order",
                         "name": "order",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a new music genre",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Genres"
-                ],
-                "summary": "Create new genre",
-                "parameters": [
                     {
-                        "description": "Genre Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateGenreRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
+                        "type": "integer",
+                        "description": "Filter by approval status (0 or 1)",
+                        "name": "is_approved",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    }
-                }
-            }
-        },
-        "/genres/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get genre details by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Genres"
-                ],
-                "summary": "Get genre by ID",
-                "parameters": [
                     {
                         "type": "integer",
-                        "description": "Genre ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by top 100 status (0 or 1)",
+                        "name": "is_top100",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by submitted_by (artist, label, admin)",
+                        "name": "submitted_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Swap explicit tracks to their clean version when available",
+                        "name": "safe",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2734,15 +5198,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -2757,13 +5221,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update genre information",
+                "description": "Create a new music track",
                 "consumes": [
                     "application/json"
                 ],
@@ -2771,30 +5235,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Genres"
+                    "Musics"
                 ],
-                "summary": "Update genre",
+                "summary": "Create new music",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Genre ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update Genre Request",
+                        "description": "Music Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateGenreRequest"
+                            "$ref": "#/definitions/handlers.CreateMusicRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -2814,13 +5271,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2829,14 +5279,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/musics/top-by-popularity": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a genre by ID",
+                "description": "Get musics ranked by a 0-100 popularity score. raw = POPULARITY_WEIGHT_PLAYS * plays in the last POPULARITY_WINDOW_DAYS + POPULARITY_WEIGHT_LIKES * like_count + POPULARITY_WEIGHT_PLAYLISTS * playlist inclusions; popularity = 100 * raw / highest raw across all musics",
                 "consumes": [
                     "application/json"
                 ],
@@ -2844,16 +5296,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Genres"
+                    "Musics"
                 ],
-                "summary": "Delete genre",
+                "summary": "Get top musics by popularity",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Genre ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 10,
+                        "description": "Number of musics (max PAGINATION_MAX_LIMIT)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2871,13 +5323,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2888,86 +5333,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/images": {
+        "/musics/top-streamed": {
             "get": {
-                "description": "Get paginated list of uploaded images",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Images"
-                ],
-                "summary": "Get all images",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    }
-                }
-            }
-        },
-        "/images/multiple": {
-            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload multiple image files to Firebase Storage",
+                "description": "Get top 5 music tracks sorted by play count (most streamed)",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Images"
-                ],
-                "summary": "Upload multiple images",
-                "parameters": [
-                    {
-                        "type": "file",
-                        "description": "Image files",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Folder path (default: images)",
-                        "name": "folder",
-                        "in": "formData"
-                    }
+                    "Musics"
                 ],
+                "summary": "Get top 5 most streamed music",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2976,13 +5359,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -3000,14 +5376,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/images/upload": {
+        "/musics/upload": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload a single image file to Firebase Storage",
+                "description": "Upload a music file to Firebase Storage (max 50MB)",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -3015,20 +5391,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Images"
+                    "Musics"
                 ],
-                "summary": "Upload image",
+                "summary": "Upload music file",
                 "parameters": [
                     {
                         "type": "file",
-                        "description": "Image file",
+                        "description": "Music file (MP3, WAV, OGG, M4A, AAC, FLAC)",
                         "name": "file",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Folder path (default: images)",
+                        "description": "Folder path (default: musics)",
                         "name": "folder",
                         "in": "formData"
                     }
@@ -3065,14 +5441,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/images/{id}": {
-            "delete": {
+        "/musics/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an image by ID",
+                "description": "Get music details by ID including its 0-100 popularity score and assigned genres. Use expand to embed related artist, album and genre objects",
                 "consumes": [
                     "application/json"
                 ],
@@ -3080,16 +5456,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Images"
+                    "Musics"
                 ],
-                "summary": "Delete image",
+                "summary": "Get music by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Image ID",
+                        "description": "Music ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated relations to embed (artist, album, genre)",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the clean version when the track is explicit and one is available",
+                        "name": "safe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also embed soft deleted relations (admin only)",
+                        "name": "include_deleted_relations",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3100,6 +5495,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -3119,93 +5521,43 @@ const docTemplate = `{
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
-                        }
-                    }
-                }
-            }
-        },
-        "/music-videos": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get paginated list of music videos with filtering and search",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "MusicVideos"
-                ],
-                "summary": "Get all music videos",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Filter by artist ID",
-                        "name": "artist_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by genre",
-                        "name": "genre",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by title or artist",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Sort order",
-                        "name": "order",
-                        "in": "query"
-                    },
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
                     {
-                        "type": "integer",
-                        "description": "Filter by approval status (0, 1, or 2)",
-                        "name": "is_approved",
-                        "in": "query"
-                    },
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update music information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Musics"
+                ],
+                "summary": "Update music",
+                "parameters": [
                     {
                         "type": "integer",
-                        "description": "Filter by highlight status (0 or 1)",
-                        "name": "is_highlight",
-                        "in": "query"
+                        "description": "Music ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by submitter (artist, label, admin)",
-                        "name": "submitted_by",
-                        "in": "query"
+                        "description": "Update Music Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateMusicRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -3216,6 +5568,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -3223,6 +5582,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3232,13 +5598,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new music video",
+                "description": "Soft delete a music by ID. Linked versions of a deleted main version are moved under the oldest remaining version",
                 "consumes": [
                     "application/json"
                 ],
@@ -3246,37 +5612,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MusicVideos"
+                    "Musics"
                 ],
-                "summary": "Create new music video",
+                "summary": "Delete music",
                 "parameters": [
                     {
-                        "description": "Music Video Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateMusicVideoRequest"
-                        }
+                        "type": "integer",
+                        "description": "Music ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -3292,37 +5656,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/music-videos/upload": {
-            "post": {
+        "/musics/{id}/approve": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload a music video file to Firebase Storage (max 150MB)",
+                "description": "Approve a music track and set the approver",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "MusicVideos"
+                    "Musics"
                 ],
-                "summary": "Upload music video file",
+                "summary": "Approve music",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Video file (MP4, MOV, AVI, WMV, WebM, MKV, 3GP)",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Music ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Folder path (default: music-videos)",
-                        "name": "folder",
-                        "in": "formData"
+                        "description": "Approve Music Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ApproveMusicRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -3347,6 +5714,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3357,14 +5731,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/music-videos/{id}": {
-            "get": {
+        "/musics/{id}/like": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get music video details by ID",
+                "description": "Increment like count for a music track",
                 "consumes": [
                     "application/json"
                 ],
@@ -3372,13 +5746,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MusicVideos"
+                    "Musics"
                 ],
-                "summary": "Get music video by ID",
+                "summary": "Increment like count",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music Video ID",
+                        "description": "Music ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3414,14 +5788,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/musics/{id}/play": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update music video information",
+                "description": "Increment play count for a music track",
                 "consumes": [
                     "application/json"
                 ],
@@ -3429,25 +5805,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MusicVideos"
+                    "Musics"
                 ],
-                "summary": "Update music video",
+                "summary": "Increment play count",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music Video ID",
+                        "description": "Music ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update Music Video Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateMusicVideoRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -3458,13 +5825,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -3487,14 +5847,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/musics/{id}/stream": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a music video by ID",
+                "description": "Increment total stream count for a music track",
                 "consumes": [
                     "application/json"
                 ],
@@ -3502,13 +5864,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MusicVideos"
+                    "Musics"
                 ],
-                "summary": "Delete music video",
+                "summary": "Increment stream count",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music Video ID",
+                        "description": "Music ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3546,14 +5908,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/music-videos/{id}/approve": {
-            "put": {
+        "/musics/{id}/unlike": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Approve a music video by ID",
+                "description": "Decrement like count for a music track (unlike)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3561,25 +5923,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MusicVideos"
+                    "Musics"
                 ],
-                "summary": "Approve music video",
+                "summary": "Decrement like count",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music Video ID",
+                        "description": "Music ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Approve Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.ApproveMusicVideoRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -3590,13 +5943,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -3621,14 +5967,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/music-videos/{id}/stream": {
-            "post": {
+        "/musics/{id}/versions": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Increment total stream count for a music video",
+                "description": "Get every linked version (e.g. explicit and clean) of the same track, the main version first",
                 "consumes": [
                     "application/json"
                 ],
@@ -3636,13 +5982,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MusicVideos"
+                    "Musics"
                 ],
-                "summary": "Increment stream count",
+                "summary": "Get music versions",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music Video ID",
+                        "description": "Music ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3680,14 +6026,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/musics": {
+        "/news": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of musics with filtering and search",
+                "description": "Get paginated list of news articles with filtering and search",
                 "consumes": [
                     "application/json"
                 ],
@@ -3695,9 +6041,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
+                    "News"
                 ],
-                "summary": "Get all musics",
+                "summary": "Get all news",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3715,58 +6061,46 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by artist",
-                        "name": "artist",
+                        "description": "Filter by category",
+                        "name": "category",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by genre",
-                        "name": "genre",
+                        "description": "Filter by author",
+                        "name": "author",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Filter by album ID",
-                        "name": "album_id",
+                        "type": "boolean",
+                        "description": "Filter by published status",
+                        "name": "published",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Search by title, artist, or album",
+                        "description": "Search by title or content. Search results are trimmed and omit content",
                         "name": "search",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "default": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Sort order",
-                        "name": "order",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Filter by approval status (0 or 1)",
-                        "name": "is_approved",
+                        "type": "string",
+                        "description": "Include omitted fields in search results (content)",
+                        "name": "expand",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Filter by top 100 status (0 or 1)",
-                        "name": "is_top100",
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by submitted_by (artist, label, admin)",
-                        "name": "submitted_by",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
                         "in": "query"
                     }
                 ],
@@ -3778,6 +6112,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -3800,7 +6141,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new music track",
+                "description": "Create a new news article",
                 "consumes": [
                     "application/json"
                 ],
@@ -3808,17 +6149,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
+                    "News"
                 ],
-                "summary": "Create new music",
+                "summary": "Create new news",
                 "parameters": [
                     {
-                        "description": "Music Request",
+                        "description": "News Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateMusicRequest"
+                            "$ref": "#/definitions/handlers.CreateNewsRequest"
                         }
                     }
                 ],
@@ -3854,14 +6195,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/musics/top-streamed": {
+        "/news/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get top 5 music tracks sorted by play count (most streamed)",
+                "description": "Get a single news article by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3869,65 +6210,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
-                ],
-                "summary": "Get top 5 most streamed music",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    }
-                }
-            }
-        },
-        "/musics/upload": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Upload a music file to Firebase Storage (max 50MB)",
-                "consumes": [
-                    "multipart/form-data"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Musics"
+                    "News"
                 ],
-                "summary": "Upload music file",
+                "summary": "Get news by ID",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Music file (MP3, WAV, OGG, M4A, AAC, FLAC)",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "News ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Folder path (default: musics)",
-                        "name": "folder",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -3938,15 +6230,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -3960,16 +6252,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/musics/{id}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get music details by ID",
+                "description": "Update news article information",
                 "consumes": [
                     "application/json"
                 ],
@@ -3977,16 +6267,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
+                    "News"
                 ],
-                "summary": "Get music by ID",
+                "summary": "Update news",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music ID",
+                        "description": "News ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update News Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateNewsRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -3997,6 +6296,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -4020,13 +6326,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update music information",
+                "description": "Soft delete a news article by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4034,25 +6340,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
+                    "News"
                 ],
-                "summary": "Update music",
+                "summary": "Delete news",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music ID",
+                        "description": "News ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update Music Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateMusicRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -4063,13 +6360,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -4092,14 +6382,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/notifications": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a music by ID",
+                "description": "Get paginated list of notifications with filtering",
                 "consumes": [
                     "application/json"
                 ],
@@ -4107,16 +6399,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
+                    "Notifications"
                 ],
-                "summary": "Delete music",
+                "summary": "Get all notifications",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page (max PAGINATION_MAX_LIMIT)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Skip total count and return has_more only",
+                        "name": "skip_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by title or message",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4127,15 +6459,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -4149,16 +6481,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/musics/{id}/approve": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Approve a music track and set the approver",
+                "description": "Create a new notification",
                 "consumes": [
                     "application/json"
                 ],
@@ -4166,51 +6496,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
+                    "Notifications"
                 ],
-                "summary": "Approve music",
+                "summary": "Create new notification",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Music ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Approve Music Request",
+                        "description": "Notification Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.ApproveMusicRequest"
+                            "$ref": "#/definitions/handlers.CreateNotificationRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Skipped because the user disabled this type",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -4226,14 +6549,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/musics/{id}/like": {
-            "post": {
+        "/notifications/preferences": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Increment like count for a music track",
+                "description": "Get notification type preferences of the authenticated user. Everything is enabled by default",
                 "consumes": [
                     "application/json"
                 ],
@@ -4241,18 +6564,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
-                ],
-                "summary": "Increment like count",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Music ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Notifications"
                 ],
+                "summary": "Get my notification preferences",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4268,13 +6582,6 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4283,16 +6590,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/musics/{id}/play": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Increment play count for a music track",
+                "description": "Enable or disable notification types (info, success, warning, error) for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -4300,16 +6605,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
+                    "Notifications"
                 ],
-                "summary": "Increment play count",
+                "summary": "Update my notification preferences",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Music ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Update Notification Preference Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateNotificationPreferenceRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -4320,15 +6627,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -4344,14 +6651,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/musics/{id}/stream": {
-            "post": {
+        "/notifications/user/{user_id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Increment total stream count for a music track",
+                "description": "Get paginated list of notifications for a specific user. For incremental sync pass since_id to get only newer notifications in ascending id order, at most limit per call; max_id in the response is the since_id for the next sync",
                 "consumes": [
                     "application/json"
                 ],
@@ -4359,16 +6666,69 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
+                    "Notifications"
                 ],
-                "summary": "Increment stream count",
+                "summary": "Get user notifications",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (ignored when since_id is set)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page (max PAGINATION_MAX_LIMIT)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Skip total count and return has_more only",
+                        "name": "skip_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by read status",
+                        "name": "is_read",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only notifications with id greater than this, ordered by id ascending (page, sort_by and order are ignored)",
+                        "name": "since_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4379,15 +6739,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -4401,16 +6761,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/musics/{id}/unlike": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Decrement like count for a music track (unlike)",
+                "description": "Soft delete all notifications of a user, optionally only those of a given type. Only the owner or an admin can do this",
                 "consumes": [
                     "application/json"
                 ],
@@ -4418,16 +6776,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Musics"
+                    "Notifications"
                 ],
-                "summary": "Decrement like count",
+                "summary": "Delete user notifications",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Music ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only delete notifications of this type (info, success, warning, error)",
+                        "name": "type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4438,6 +6802,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -4445,8 +6816,8 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -4462,14 +6833,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/news": {
-            "get": {
+        "/notifications/user/{user_id}/read-all": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of news articles with filtering and search",
+                "description": "Mark all notifications for a specific user as read",
                 "consumes": [
                     "application/json"
                 ],
@@ -4477,61 +6848,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Notifications"
                 ],
-                "summary": "Get all news",
+                "summary": "Mark all notifications as read",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by category",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by author",
-                        "name": "author",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Filter by published status",
-                        "name": "published",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by title or content",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Sort order",
-                        "name": "order",
-                        "in": "query"
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -4557,14 +6883,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/notifications/user/{user_id}/unread-by-type": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new news article",
+                "description": "Get the number of unread notifications for a specific user grouped by type",
                 "consumes": [
                     "application/json"
                 ],
@@ -4572,30 +6900,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Notifications"
                 ],
-                "summary": "Create new news",
+                "summary": "Get unread notification counts by type",
                 "parameters": [
                     {
-                        "description": "News Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateNewsRequest"
-                        }
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -4618,14 +6937,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/news/{id}": {
+        "/notifications/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single news article by ID",
+                "description": "Get notification details by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4633,13 +6952,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Notifications"
                 ],
-                "summary": "Get news by ID",
+                "summary": "Get notification by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "News ID",
+                        "description": "Notification ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -4682,7 +7001,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update news article information",
+                "description": "Update notification information",
                 "consumes": [
                     "application/json"
                 ],
@@ -4690,24 +7009,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Notifications"
                 ],
-                "summary": "Update news",
+                "summary": "Update notification",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "News ID",
+                        "description": "Notification ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update News Request",
+                        "description": "Update Notification Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateNewsRequest"
+                            "$ref": "#/definitions/handlers.UpdateNotificationRequest"
                         }
                     }
                 ],
@@ -4755,7 +7074,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a news article by ID",
+                "description": "Soft delete a notification by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4763,13 +7082,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Notifications"
                 ],
-                "summary": "Delete news",
+                "summary": "Delete notification",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "News ID",
+                        "description": "Notification ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -4807,14 +7126,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications": {
-            "get": {
+        "/notifications/{id}/read": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of notifications with filtering",
+                "description": "Mark a notification as read by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4824,47 +7143,14 @@ const docTemplate = `{
                 "tags": [
                     "Notifications"
                 ],
-                "summary": "Get all notifications",
+                "summary": "Mark notification as read",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by type",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by title or message",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Sort order",
-                        "name": "order",
-                        "in": "query"
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -4882,6 +7168,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4890,14 +7183,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/playlist-songs": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new notification",
+                "description": "Add a music track to a playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -4905,17 +7200,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "PlaylistSongs"
                 ],
-                "summary": "Create new notification",
+                "summary": "Add song to playlist",
                 "parameters": [
                     {
-                        "description": "Notification Request",
+                        "description": "Playlist Song Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateNotificationRequest"
+                            "$ref": "#/definitions/handlers.CreatePlaylistSongRequest"
                         }
                     }
                 ],
@@ -4941,6 +7236,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4951,14 +7253,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications/user/{user_id}": {
+        "/playlist-songs/playlist/{playlist_id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of notifications for a specific user",
+                "description": "Get all songs in a playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -4966,56 +7268,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "PlaylistSongs"
                 ],
-                "summary": "Get user notifications",
+                "summary": "Get playlist songs",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Playlist ID",
+                        "name": "playlist_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Filter by read status",
-                        "name": "is_read",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by type",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "created_at",
-                        "description": "Sort field",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Sort order",
-                        "name": "order",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -5043,14 +7305,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications/user/{user_id}/read-all": {
-            "post": {
+        "/playlist-songs/playlist/{playlist_id}/music/{music_id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mark all notifications for a specific user as read",
+                "description": "Remove a song from playlist by playlist ID and music ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -5058,14 +7320,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "PlaylistSongs"
                 ],
-                "summary": "Mark all notifications as read",
+                "summary": "Delete playlist song by playlist and music",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Playlist ID",
+                        "name": "playlist_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Music ID",
+                        "name": "music_id",
                         "in": "path",
                         "required": true
                     }
@@ -5085,6 +7354,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5095,14 +7378,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications/{id}": {
+        "/playlist-songs/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get notification details by ID",
+                "description": "Get playlist song details by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -5110,13 +7393,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "PlaylistSongs"
                 ],
-                "summary": "Get notification by ID",
+                "summary": "Get playlist song by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Notification ID",
+                        "description": "Playlist Song ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5159,7 +7442,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update notification information",
+                "description": "Update playlist song position or information",
                 "consumes": [
                     "application/json"
                 ],
@@ -5167,24 +7450,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "PlaylistSongs"
                 ],
-                "summary": "Update notification",
+                "summary": "Update playlist song",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Notification ID",
+                        "description": "Playlist Song ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update Notification Request",
+                        "description": "Update Playlist Song Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateNotificationRequest"
+                            "$ref": "#/definitions/handlers.UpdatePlaylistSongRequest"
                         }
                     }
                 ],
@@ -5210,6 +7493,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -5232,7 +7522,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a notification by ID",
+                "description": "Remove a song from playlist by playlist song ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -5240,13 +7530,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "PlaylistSongs"
                 ],
-                "summary": "Delete notification",
+                "summary": "Delete playlist song",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Notification ID",
+                        "description": "Playlist Song ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5267,6 +7557,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -5284,14 +7581,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications/{id}/read": {
-            "post": {
+        "/playlists": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mark a notification as read by ID",
+                "description": "Get paginated list of playlists with filtering and search",
                 "consumes": [
                     "application/json"
                 ],
@@ -5299,16 +7596,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Playlists"
                 ],
-                "summary": "Mark notification as read",
+                "summary": "Get all playlists",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Notification ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by user ID",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name",
+                        "name": "search",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -5319,15 +7635,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -5341,16 +7657,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/playlist-songs": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add a music track to a playlist",
+                "description": "Create a new playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -5358,17 +7672,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PlaylistSongs"
+                    "Playlists"
                 ],
-                "summary": "Add song to playlist",
+                "summary": "Create new playlist",
                 "parameters": [
                     {
-                        "description": "Playlist Song Request",
+                        "description": "Playlist Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreatePlaylistSongRequest"
+                            "$ref": "#/definitions/handlers.CreatePlaylistRequest"
                         }
                     }
                 ],
@@ -5404,14 +7718,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlist-songs/playlist/{playlist_id}": {
+        "/playlists/me": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all songs in a playlist",
+                "description": "Get paginated playlists the authenticated user owns or collaborates on",
                 "consumes": [
                     "application/json"
                 ],
@@ -5419,75 +7733,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PlaylistSongs"
+                    "Playlists"
                 ],
-                "summary": "Get playlist songs",
+                "summary": "Get my playlists",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Playlist ID",
-                        "name": "playlist_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
+                        "type": "string",
+                        "description": "Filter by relation (owner, collaborator). Empty returns both",
+                        "name": "role",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    }
-                }
-            }
-        },
-        "/playlist-songs/playlist/{playlist_id}/music/{music_id}": {
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Remove a song from playlist by playlist ID and music ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "PlaylistSongs"
-                ],
-                "summary": "Delete playlist song by playlist and music",
-                "parameters": [
                     {
                         "type": "integer",
-                        "description": "Playlist ID",
-                        "name": "playlist_id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Music ID",
-                        "name": "music_id",
-                        "in": "path",
-                        "required": true
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -5498,15 +7766,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -5522,14 +7790,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlist-songs/{id}": {
+        "/playlists/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get playlist song details by ID",
+                "description": "Get playlist details by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -5537,13 +7805,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PlaylistSongs"
+                    "Playlists"
                 ],
-                "summary": "Get playlist song by ID",
+                "summary": "Get playlist by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Playlist Song ID",
+                        "description": "Playlist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5586,7 +7854,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update playlist song position or information",
+                "description": "Update playlist information (owner, collaborators, or admin)",
                 "consumes": [
                     "application/json"
                 ],
@@ -5594,24 +7862,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PlaylistSongs"
+                    "Playlists"
                 ],
-                "summary": "Update playlist song",
+                "summary": "Update playlist",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Playlist Song ID",
+                        "description": "Playlist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update Playlist Song Request",
+                        "description": "Update Playlist Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdatePlaylistSongRequest"
+                            "$ref": "#/definitions/handlers.UpdatePlaylistRequest"
                         }
                     }
                 ],
@@ -5637,6 +7905,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -5659,7 +7934,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove a song from playlist by playlist song ID",
+                "description": "Soft delete a playlist by ID (owner or admin)",
                 "consumes": [
                     "application/json"
                 ],
@@ -5667,13 +7942,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PlaylistSongs"
+                    "Playlists"
                 ],
-                "summary": "Delete playlist song",
+                "summary": "Delete playlist",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Playlist Song ID",
+                        "description": "Playlist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5694,6 +7969,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -5711,14 +7993,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlists": {
+        "/playlists/{id}/collaborators": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of playlists with filtering and search",
+                "description": "Get all collaborators of a playlist (owner, collaborators, or admin)",
                 "consumes": [
                     "application/json"
                 ],
@@ -5728,33 +8010,14 @@ const docTemplate = `{
                 "tags": [
                     "Playlists"
                 ],
-                "summary": "Get all playlists",
+                "summary": "Get playlist collaborators",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Filter by user ID",
-                        "name": "user_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by name",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5772,60 +8035,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a new playlist",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Playlists"
-                ],
-                "summary": "Create new playlist",
-                "parameters": [
-                    {
-                        "description": "Playlist Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreatePlaylistRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -5839,16 +8057,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/playlists/{id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get playlist details by ID",
+                "description": "Add a user as collaborator of a playlist. Collaborators can edit the playlist and its songs (owner or admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -5858,7 +8074,7 @@ const docTemplate = `{
                 "tags": [
                     "Playlists"
                 ],
-                "summary": "Get playlist by ID",
+                "summary": "Add playlist collaborator",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5866,11 +8082,27 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Add Collaborator Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddPlaylistCollaboratorRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -5883,6 +8115,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -5890,6 +8129,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5898,14 +8144,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/playlists/{id}/collaborators/{user_id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update playlist information",
+                "description": "Remove a collaborator from a playlist (owner or admin). A collaborator can also remove themselves to leave the playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -5915,7 +8163,7 @@ const docTemplate = `{
                 "tags": [
                     "Playlists"
                 ],
-                "summary": "Update playlist",
+                "summary": "Remove playlist collaborator",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5925,13 +8173,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Update Playlist Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdatePlaylistRequest"
-                        }
+                        "type": "integer",
+                        "description": "Collaborator User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5942,15 +8188,15 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -5971,14 +8217,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/playlists/{id}/transfer": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a playlist by ID",
+                "description": "Transfer playlist ownership to one of its collaborators. The previous owner becomes a collaborator (owner only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -5988,7 +8236,7 @@ const docTemplate = `{
                 "tags": [
                     "Playlists"
                 ],
-                "summary": "Delete playlist",
+                "summary": "Transfer playlist ownership",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5996,6 +8244,15 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Transfer Playlist Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TransferPlaylistRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -6006,6 +8263,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -6013,6 +8277,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -6110,6 +8381,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -6440,6 +8718,65 @@ const docTemplate = `{
                 }
             }
         },
+        "/podcasts/{id}/next": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the following episode of the same show (same host) ordered by season and episode number. Returns null data at the end of the series.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Podcasts"
+                ],
+                "summary": "Get next podcast episode",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Podcast ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
         "/podcasts/{id}/stream": {
             "post": {
                 "security": [
@@ -6549,6 +8886,49 @@ const docTemplate = `{
                 }
             }
         },
+        "/profile/playlists": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete all playlists owned by the authenticated user together with their songs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlists"
+                ],
+                "summary": "Delete all my playlists",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
         "/srs/on_publish": {
             "post": {
                 "description": "Called by SRS when a stream publisher connects. Marks stream as live.",
@@ -6740,6 +9120,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -7476,6 +9863,17 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "handlers.AddPlaylistCollaboratorRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "handlers.ApproveMusicRequest": {
             "type": "object",
             "required": [
@@ -7498,6 +9896,25 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.AssignMusicGenresRequest": {
+            "type": "object",
+            "required": [
+                "genre_ids",
+                "music_id"
+            ],
+            "properties": {
+                "genre_ids": {
+                    "description": "Genre pertama menjadi genre utama music",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "music_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "handlers.CreateAppInfoRequest": {
             "type": "object",
             "required": [
@@ -7727,6 +10144,9 @@ const docTemplate = `{
                 "notes": {
                     "type": "string"
                 },
+                "parent_music_id": {
+                    "type": "integer"
+                },
                 "play_count": {
                     "type": "integer"
                 },
@@ -7742,6 +10162,9 @@ const docTemplate = `{
                 },
                 "title": {
                     "type": "string"
+                },
+                "version_label": {
+                    "type": "string"
                 }
             }
         },
@@ -8075,6 +10498,17 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.PinArtistMusicRequest": {
+            "type": "object",
+            "required": [
+                "music_id"
+            ],
+            "properties": {
+                "music_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "handlers.RegisterRequest": {
             "type": "object",
             "required": [
@@ -8111,6 +10545,14 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "live_from": {
+                    "description": "\"web\" or \"phone\"",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.StreamLiveFrom"
+                        }
+                    ]
+                },
                 "scheduled_at": {
                     "type": "string"
                 },
@@ -8122,6 +10564,17 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.TransferPlaylistRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "handlers.UpdateAppInfoRequest": {
             "type": "object",
             "properties": {
@@ -8252,6 +10705,17 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.UpdateEditorialWeightRequest": {
+            "type": "object",
+            "required": [
+                "editorial_weight"
+            ],
+            "properties": {
+                "editorial_weight": {
+                    "type": "integer"
+                }
+            }
+        },
         "handlers.UpdateGenreRequest": {
             "type": "object",
             "properties": {
@@ -8326,6 +10790,10 @@ const docTemplate = `{
                 "notes": {
                     "type": "string"
                 },
+                "parent_music_id": {
+                    "description": "0 untuk melepas hubungan versi",
+                    "type": "integer"
+                },
                 "play_count": {
                     "type": "integer"
                 },
@@ -8344,6 +10812,9 @@ const docTemplate = `{
                 },
                 "total_stream": {
                     "type": "integer"
+                },
+                "version_label": {
+                    "type": "string"
                 }
             }
         },
@@ -8429,6 +10900,23 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.UpdateNotificationPreferenceRequest": {
+            "type": "object",
+            "properties": {
+                "error_enabled": {
+                    "type": "boolean"
+                },
+                "info_enabled": {
+                    "type": "boolean"
+                },
+                "success_enabled": {
+                    "type": "boolean"
+                },
+                "warning_enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
         "handlers.UpdateNotificationRequest": {
             "type": "object",
             "properties": {
@@ -8617,6 +11105,17 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "models.StreamLiveFrom": {
+            "type": "string",
+            "enum": [
+                "web",
+                "phone"
+            ],
+            "x-enum-varnames": [
+                "StreamLiveFromWeb",
+                "StreamLiveFromPhone"
+            ]
         }
     },
     "securityDefinitions": {
@@ -1,11 +1,9 @@
 package handlers
 
 import (
-	"backend_soundcave/config"
 	"backend_soundcave/models"
-	"context"
+	"backend_soundcave/utils"
 	"fmt"
-	"io"
 	"mime/multipart"
 	"net/url"
 	"os"
@@ -166,6 +164,7 @@ func CreateAlbumHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        sort_by  query     string  false  "Sort field" default(created_at)
 // @Param        order    query     string  false  "Sort order" default(desc)
 // @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -203,9 +202,12 @@ func GetAlbumsHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	// Sort by created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
@@ -236,22 +238,25 @@ func GetAlbumsHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 // GetAlbumHandler mendapatkan album by ID
 // @Summary      Get album by ID
-// @Description  Get album details by ID
+// @Description  Get album details by ID including its artist. Admins can pass include_deleted_relations=true to also resolve a soft deleted artist
 // @Tags         Albums
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "Album ID"
-// @Success      200  {object}  map[string]interface{}
-// @Failure      401  {object}  map[string]interface{}
-// @Failure      404  {object}  map[string]interface{}
-// @Failure      500  {object}  map[string]interface{}
+// @Param        id                         path      int   true   "Album ID"
+// @Param        include_deleted_relations  query     bool  false  "Include soft deleted related rows (admin only)" default(false)
+// @Success      200                        {object}  map[string]interface{}
+// @Failure      401                        {object}  map[string]interface{}
+// @Failure      404                        {object}  map[string]interface{}
+// @Failure      500                        {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /albums/{id} [get]
 func GetAlbumHandler(c *fiber.Ctx, db *gorm.DB) error {
 	id := c.Params("id")
 
 	var album models.Album
-	if err := db.First(&album, id).Error; err != nil {
+	if err := db.Preload("ArtistData", func(tx *gorm.DB) *gorm.DB {
+		return relationScope(c, tx)
+	}).First(&album, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"success": false,
@@ -495,9 +500,9 @@ func uploadAlbumImageToFirebase(c *fiber.Ctx, file *multipart.FileHeader) (strin
 	filename := fmt.Sprintf("%d%s", time.Now().UnixNano(), ext)
 	bucketPath := fmt.Sprintf("albums/%s", filename)
 
-	// Upload ke Firebase Storage
-	ctx := context.Background()
-	bucket, err := config.GetStorageBucket()
+	// Upload ke Firebase Storage, context mengikuti request agar upload dibatalkan saat request timeout
+	ctx := c.UserContext()
+	bucket, err := storageBucket()
 	if err != nil {
 		return "", err
 	}
@@ -505,20 +510,9 @@ func uploadAlbumImageToFirebase(c *fiber.Ctx, file *multipart.FileHeader) (strin
 		return "", fmt.Errorf("firebase storage bucket tidak tersedia")
 	}
 
-	// Buat object writer
+	// Upload file ke Firebase Storage
 	obj := bucket.Object(bucketPath)
-	writer := obj.NewWriter(ctx)
-	writer.ContentType = file.Header.Get("Content-Type")
-	writer.CacheControl = "public, max-age=31536000"
-
-	// Copy file ke Firebase Storage
-	if _, err := io.Copy(writer, src); err != nil {
-		writer.Close()
-		return "", err
-	}
-
-	// Close writer
-	if err := writer.Close(); err != nil {
+	if err := writeStorageObject(ctx, obj, file.Header.Get("Content-Type"), src); err != nil {
 		return "", err
 	}
 
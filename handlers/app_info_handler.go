@@ -2,12 +2,55 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 )
 
+// latestAppInfoCacheTTL lama response app-info terbaru disimpan di memory
+const latestAppInfoCacheTTL = 60 * time.Second
+
+// latestAppInfoCacheEntry menyimpan response app-info terbaru yang sudah di-serialize
+type latestAppInfoCacheEntry struct {
+	body         []byte
+	etag         string
+	lastModified time.Time
+	expiresAt    time.Time
+}
+
+var (
+	latestAppInfoCache   *latestAppInfoCacheEntry
+	latestAppInfoCacheMu sync.RWMutex
+)
+
+// invalidateLatestAppInfoCache menghapus cache app-info terbaru setelah ada perubahan data
+func invalidateLatestAppInfoCache() {
+	latestAppInfoCacheMu.Lock()
+	latestAppInfoCache = nil
+	latestAppInfoCacheMu.Unlock()
+}
+
+// isLatestAppInfoNotModified mengecek header If-None-Match dari client.
+// If-Modified-Since tidak dipakai: setelah app-info terbaru dihapus, data pengganti bisa lebih lama
+// dari Last-Modified yang dipegang client sehingga perbandingan waktu menghasilkan 304 yang salah.
+func isLatestAppInfoNotModified(c *fiber.Ctx, entry *latestAppInfoCacheEntry) bool {
+	for _, tag := range strings.Split(c.Get(fiber.HeaderIfNoneMatch), ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == entry.etag {
+			return true
+		}
+	}
+	return false
+}
+
 // CreateAppInfoRequest struct untuk request create app_info
 type CreateAppInfoRequest struct {
 	AppName     string                 `json:"app_name" validate:"required"`
@@ -131,6 +174,8 @@ func CreateAppInfoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	invalidateLatestAppInfoCache()
+
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
 		"success": true,
 		"message": "App info berhasil dibuat",
@@ -150,6 +195,7 @@ func CreateAppInfoHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        sort_by  query     string  false  "Sort field" default(created_at)
 // @Param        order    query     string  false  "Sort order" default(desc)
 // @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -172,9 +218,12 @@ func GetAppInfosHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	// Sort by created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
@@ -242,36 +291,75 @@ func GetAppInfoHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 // GetLatestAppInfoHandler mendapatkan app_info terbaru (biasanya hanya satu)
 // @Summary      Get latest app info
-// @Description  Get the latest app information entry
+// @Description  Get the latest app information entry. Supports conditional requests via If-None-Match (ETag); Last-Modified is informational and If-Modified-Since is ignored
 // @Tags         AppInfo
 // @Accept       json
 // @Produce      json
+// @Param        If-None-Match  header    string  false  "ETag from a previous response"
 // @Success      200  {object}  map[string]interface{}
+// @Success      304  "Not Modified"
 // @Failure      401  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /app-info/latest [get]
 func GetLatestAppInfoHandler(c *fiber.Ctx, db *gorm.DB) error {
-	var appInfo models.AppInfo
-	if err := db.Order("created_at DESC").First(&appInfo).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+	latestAppInfoCacheMu.RLock()
+	entry := latestAppInfoCache
+	latestAppInfoCacheMu.RUnlock()
+
+	if entry == nil || time.Now().After(entry.expiresAt) {
+		var appInfo models.AppInfo
+		if err := db.Order("created_at DESC").First(&appInfo).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+					"success": false,
+					"message": "App info tidak ditemukan",
+				})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 				"success": false,
-				"message": "App info tidak ditemukan",
+				"message": "Gagal mengambil data app_info",
+				"error":   err.Error(),
 			})
 		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"success": false,
-			"message": "Gagal mengambil data app_info",
-			"error":   err.Error(),
+
+		body, err := json.Marshal(fiber.Map{
+			"success": true,
+			"data":    appInfo,
 		})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal memproses data app_info",
+				"error":   err.Error(),
+			})
+		}
+
+		hash := sha1.Sum(body)
+		entry = &latestAppInfoCacheEntry{
+			body:         body,
+			etag:         `"` + hex.EncodeToString(hash[:]) + `"`,
+			lastModified: appInfo.UpdatedAt.UTC().Truncate(time.Second),
+			expiresAt:    time.Now().Add(latestAppInfoCacheTTL),
+		}
+
+		latestAppInfoCacheMu.Lock()
+		latestAppInfoCache = entry
+		latestAppInfoCacheMu.Unlock()
 	}
 
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"success": true,
-		"data":    appInfo,
-	})
+	c.Set(fiber.HeaderETag, entry.etag)
+	c.Set(fiber.HeaderLastModified, entry.lastModified.Format(http.TimeFormat))
+	c.Set(fiber.HeaderCacheControl, "private, no-cache")
+
+	// Client dengan ETag yang masih sama cukup menerima 304
+	if isLatestAppInfoNotModified(c, entry) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Status(fiber.StatusOK).Send(entry.body)
 }
 
 // UpdateAppInfoHandler mengupdate app_info
@@ -385,6 +473,8 @@ func UpdateAppInfoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	invalidateLatestAppInfoCache()
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
 		"message": "App info berhasil diupdate",
@@ -431,6 +521,8 @@ func DeleteAppInfoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	invalidateLatestAppInfoCache()
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
 		"message": "App info berhasil dihapus",
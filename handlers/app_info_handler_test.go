@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetLatestAppInfoHandlerConditionalGet(t *testing.T) {
+	db := testdb.New(t)
+	invalidateLatestAppInfoCache()
+	t.Cleanup(invalidateLatestAppInfoCache)
+
+	appInfo := models.AppInfo{
+		AppName:     "SoundCave",
+		Tagline:     "Music for everyone",
+		Description: "Streaming app",
+		Email:       "hello@soundcave.id",
+		Phone:       "0800",
+		Address:     "Jakarta",
+	}
+	mustCreate(t, db, &appInfo)
+
+	latestApp := newTestApp(db, "GET", "/app-info/latest", 1, "user", GetLatestAppInfoHandler)
+	updateApp := newTestApp(db, "PUT", "/app-info/:id", 1, "admin", UpdateAppInfoHandler)
+
+	// Request pertama mengisi cache dan mengembalikan ETag
+	resp, err := latestApp.Test(httptest.NewRequest("GET", "/app-info/latest", nil), -1)
+	if err != nil {
+		t.Fatalf("request gagal: %v", err)
+	}
+	resp.Body.Close()
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != 200 || etag == "" {
+		t.Fatalf("status = %d, etag = %q, want 200 with ETag", resp.StatusCode, etag)
+	}
+
+	req := httptest.NewRequest("GET", "/app-info/latest", nil)
+	req.Header.Set("If-None-Match", etag)
+	if status, _ := sendRequest(t, latestApp, req); status != 304 {
+		t.Fatalf("matching If-None-Match status = %d, want 304", status)
+	}
+
+	req = httptest.NewRequest("GET", "/app-info/latest", nil)
+	req.Header.Set("If-None-Match", `"stale"`)
+	if status, _ := sendRequest(t, latestApp, req); status != 200 {
+		t.Errorf("non-matching If-None-Match status = %d, want 200", status)
+	}
+
+	// Update harus menghapus cache sehingga ETag lama tidak lagi cocok
+	status, body := doRequest(t, updateApp, "PUT", fmt.Sprintf("/app-info/%d", appInfo.ID), map[string]interface{}{"tagline": "New tagline"})
+	if status != 200 {
+		t.Fatalf("update status = %d, body = %v", status, body)
+	}
+
+	req = httptest.NewRequest("GET", "/app-info/latest", nil)
+	req.Header.Set("If-None-Match", etag)
+	status, body = sendRequest(t, latestApp, req)
+	if status != 200 {
+		t.Fatalf("after update status = %d, want 200", status)
+	}
+	if got := dataMap(t, body)["tagline"]; got != "New tagline" {
+		t.Errorf("tagline = %v, want New tagline", got)
+	}
+}
+
+func TestGetLatestAppInfoHandlerAfterDeletingLatest(t *testing.T) {
+	db := testdb.New(t)
+	invalidateLatestAppInfoCache()
+	t.Cleanup(invalidateLatestAppInfoCache)
+
+	older := models.AppInfo{AppName: "SoundCave", Tagline: "Old", Description: "Streaming app", Email: "hello@soundcave.id", Phone: "0800", Address: "Jakarta"}
+	newer := models.AppInfo{AppName: "SoundCave", Tagline: "New", Description: "Streaming app", Email: "hello@soundcave.id", Phone: "0800", Address: "Jakarta"}
+	mustCreate(t, db, &older)
+	mustCreate(t, db, &newer)
+	// App-info pengganti lebih lama dari Last-Modified yang dipegang client
+	db.Model(&older).UpdateColumns(map[string]interface{}{
+		"created_at": time.Now().Add(-2 * time.Hour),
+		"updated_at": time.Now().Add(-2 * time.Hour),
+	})
+
+	latestApp := newTestApp(db, "GET", "/app-info/latest", 1, "user", GetLatestAppInfoHandler)
+	deleteApp := newTestApp(db, "DELETE", "/app-info/:id", 1, "admin", DeleteAppInfoHandler)
+
+	resp, err := latestApp.Test(httptest.NewRequest("GET", "/app-info/latest", nil), -1)
+	if err != nil {
+		t.Fatalf("request gagal: %v", err)
+	}
+	resp.Body.Close()
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+
+	if status, body := doRequest(t, deleteApp, "DELETE", fmt.Sprintf("/app-info/%d", newer.ID), nil); status != 200 {
+		t.Fatalf("delete status = %d, body = %v", status, body)
+	}
+
+	for name, header := range map[string]string{"If-None-Match": etag, "If-Modified-Since": lastModified} {
+		req := httptest.NewRequest("GET", "/app-info/latest", nil)
+		req.Header.Set(name, header)
+		status, body := sendRequest(t, latestApp, req)
+		if status != 200 {
+			t.Fatalf("%s after delete status = %d, want 200", name, status)
+		}
+		if got := dataMap(t, body)["tagline"]; got != "Old" {
+			t.Errorf("%s after delete tagline = %v, want Old", name, got)
+		}
+	}
+}
@@ -2,6 +2,9 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
+	"sort"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
@@ -83,3 +86,208 @@ func GetArtistDashboardStatsHandler(c *fiber.Ctx, db *gorm.DB) error {
 		},
 	})
 }
+
+// storageUsage struct untuk hasil agregasi ukuran file per jenis konten
+type storageUsage struct {
+	Bytes       int64 `json:"bytes"`
+	Files       int64 `json:"files"`
+	UnknownSize int64 `json:"unknown_size"` // File lama yang ukurannya belum tercatat
+}
+
+// sumStorageUsage menjumlahkan kolom ukuran file dari query yang sudah difilter
+func sumStorageUsage(query *gorm.DB, sizeColumn string) (storageUsage, error) {
+	var usage storageUsage
+	err := query.Select(
+		"COALESCE(SUM(" + sizeColumn + "), 0) AS bytes, " +
+			"COUNT(*) AS files, " +
+			"COALESCE(SUM(CASE WHEN " + sizeColumn + " IS NULL THEN 1 ELSE 0 END), 0) AS unknown_size",
+	).Scan(&usage).Error
+	return usage, err
+}
+
+// GetStorageUsageHandler mendapatkan total penggunaan storage milik user independent/label
+// @Summary      Get storage usage
+// @Description  Get the storage footprint of the caller: uploaded images plus audio and video files of the artists linked to the user (ref_user_id)
+// @Tags         Dashboard
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /dashboard/storage-usage [get]
+func GetStorageUsageHandler(c *fiber.Ctx, db *gorm.DB) error {
+	userID := c.Locals("user_id").(uint)
+	role := c.Locals("role").(string)
+
+	// Validate role - only independent or label allowed
+	if role != string(models.RoleIndependent) && role != string(models.RoleLabel) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Akses ditolak. Hanya user dengan role independent atau label yang dapat mengakses",
+		})
+	}
+
+	var artistIDs []uint
+	if err := db.Model(&models.Artist{}).Where("ref_user_id = ?", userID).Pluck("id", &artistIDs).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data artist",
+			"error":   err.Error(),
+		})
+	}
+
+	images, err := sumStorageUsage(db.Model(&models.Image{}).Where("uploaded_by = ?", userID), "file_size")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghitung storage usage",
+			"error":   err.Error(),
+		})
+	}
+
+	// Audio dan video dihitung dari artist yang terhubung ke user
+	var musics, musicVideos, cavelists storageUsage
+	if len(artistIDs) > 0 {
+		musics, err = sumStorageUsage(db.Model(&models.Music{}).Where("artist_id IN ?", artistIDs), "audio_file_size")
+		if err == nil {
+			musicVideos, err = sumStorageUsage(db.Model(&models.MusicVideo{}).Where("artist_id IN ?", artistIDs), "video_file_size")
+		}
+		if err == nil {
+			cavelists, err = sumStorageUsage(db.Model(&models.Cavelist{}).Where("artist_id IN ?", artistIDs), "video_file_size")
+		}
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal menghitung storage usage",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"user_id":      userID,
+			"artist_ids":   artistIDs,
+			"images":       images,
+			"musics":       musics,
+			"music_videos": musicVideos,
+			"cavelists":    cavelists,
+			"total_bytes":  images.Bytes + musics.Bytes + musicVideos.Bytes + cavelists.Bytes,
+		},
+	})
+}
+
+// ListenerCountry struct untuk jumlah play dan listener per negara
+type ListenerCountry struct {
+	Country   string `json:"country"`
+	Plays     int64  `json:"plays"`
+	Listeners int64  `json:"listeners"`
+}
+
+// GetListenerDemographicsHandler mendapatkan sebaran negara listener music milik user
+// @Summary      Get listener demographics
+// @Description  Get plays and unique listeners per country for the tracks of the artists linked to the caller (ref_user_id) in the last N days. Country is derived from the listener's profile location
+// @Tags         Dashboard
+// @Accept       json
+// @Produce      json
+// @Param        days  query     int  false  "Number of days (max 365)" default(30)
+// @Security     BearerAuth
+// @Success      200   {object}  map[string]interface{}
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      403   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Router       /dashboard/listener-demographics [get]
+func GetListenerDemographicsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	userID := c.Locals("user_id").(uint)
+	role := c.Locals("role").(string)
+
+	// Validate role - only independent or label allowed
+	if role != string(models.RoleIndependent) && role != string(models.RoleLabel) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Akses ditolak. Hanya user dengan role independent atau label yang dapat mengakses",
+		})
+	}
+
+	days := c.QueryInt("days", 30)
+	if days < 1 || days > 365 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "days harus antara 1 dan 365",
+		})
+	}
+
+	var artistIDs []uint
+	if err := db.Model(&models.Artist{}).Where("ref_user_id = ?", userID).Pluck("id", &artistIDs).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data artist",
+			"error":   err.Error(),
+		})
+	}
+
+	countries := make([]ListenerCountry, 0)
+	var totalPlays, totalListeners int64
+
+	if len(artistIDs) > 0 {
+		var rows []struct {
+			Location  *string
+			Plays     int64
+			Listeners int64
+		}
+		if err := db.Table("play_events").
+			Select("users.location AS location, COUNT(*) AS plays, COUNT(DISTINCT play_events.user_id) AS listeners").
+			Joins("JOIN musics ON musics.id = play_events.music_id AND musics.deleted_at IS NULL").
+			Joins("JOIN users ON users.id = play_events.user_id").
+			Where("musics.artist_id IN ? AND play_events.created_at >= ?", artistIDs, time.Now().AddDate(0, 0, -days)).
+			Group("users.location").
+			Scan(&rows).Error; err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil data listener",
+				"error":   err.Error(),
+			})
+		}
+
+		// Location ditulis bebas oleh user, gabungkan setelah dinormalisasi ke negara
+		byCountry := make(map[string]*ListenerCountry)
+		for _, row := range rows {
+			location := ""
+			if row.Location != nil {
+				location = *row.Location
+			}
+			country := utils.NormalizeCountry(location)
+			if _, ok := byCountry[country]; !ok {
+				byCountry[country] = &ListenerCountry{Country: country}
+			}
+			byCountry[country].Plays += row.Plays
+			byCountry[country].Listeners += row.Listeners
+		}
+
+		for _, entry := range byCountry {
+			totalPlays += entry.Plays
+			totalListeners += entry.Listeners
+			countries = append(countries, *entry)
+		}
+		sort.Slice(countries, func(i, j int) bool {
+			if countries[i].Plays != countries[j].Plays {
+				return countries[i].Plays > countries[j].Plays
+			}
+			return countries[i].Country < countries[j].Country
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    countries,
+		"summary": fiber.Map{
+			"days":      days,
+			"plays":     totalPlays,
+			"listeners": totalListeners,
+		},
+	})
+}
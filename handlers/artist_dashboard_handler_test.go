@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/gofiber/fiber/v2"
+)
+
+// useTestStorage mengarahkan upload handler ke bucket Storage test
+func useTestStorage(t *testing.T) {
+	t.Helper()
+
+	bucket := newTestBucket(t, func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"bucket":"soundcave-test","name":"object"}`)
+	})
+	t.Setenv("FIREBASE_STORAGE_BUCKET", "soundcave-test")
+
+	original := storageBucket
+	storageBucket = func() (*storage.BucketHandle, error) { return bucket, nil }
+	t.Cleanup(func() { storageBucket = original })
+}
+
+// uploadTestFile mengirim file multipart ke endpoint upload dan mengembalikan file_url dari response
+func uploadTestFile(t *testing.T, app *fiber.App, target, contentType string, content []byte) string {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="file"; filename="file.bin"`)
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(content)
+	writer.Close()
+
+	req := httptest.NewRequest("POST", target, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	status, resp := sendRequest(t, app, req)
+	if status != 200 {
+		t.Fatalf("upload status = %d, body = %v", status, resp)
+	}
+	fileURL, _ := dataMap(t, resp)["file_url"].(string)
+	if !strings.Contains(fileURL, "soundcave-test") {
+		t.Fatalf("file_url = %q, want URL in the test bucket", fileURL)
+	}
+	return fileURL
+}
+
+func TestStorageUsageIncreasesAfterUpload(t *testing.T) {
+	db := testdb.New(t)
+	useTestStorage(t)
+	artist := seedArtist(t, db, "Label Artist", 5)
+
+	usageApp := newTestApp(db, "GET", "/dashboard/storage-usage", 5, "label", GetStorageUsageHandler)
+	totalBytes := func() float64 {
+		t.Helper()
+		status, body := doRequest(t, usageApp, "GET", "/dashboard/storage-usage", nil)
+		if status != 200 {
+			t.Fatalf("usage status = %d, body = %v", status, body)
+		}
+		return dataMap(t, body)["total_bytes"].(float64)
+	}
+
+	if got := totalBytes(); got != 0 {
+		t.Fatalf("initial total_bytes = %v, want 0", got)
+	}
+
+	audio := bytes.Repeat([]byte("a"), 1234)
+	upload := newTestApp(db, "POST", "/musics/upload", 5, "label", UploadMusicHandler)
+	fileURL := uploadTestFile(t, upload, "/musics/upload", "audio/mpeg", audio)
+
+	// Ukuran dari client diabaikan, yang dipakai ukuran yang diterima saat upload
+	create := newTestApp(db, "POST", "/musics", 5, "label", CreateMusicHandler)
+	status, body := doRequest(t, create, "POST", "/musics", map[string]interface{}{
+		"title":           "Uploaded",
+		"artist":          artist.Name,
+		"artist_id":       artist.ID,
+		"genre":           "Pop",
+		"release_date":    "2026-01-02",
+		"duration":        "03:00",
+		"language":        "Indonesian",
+		"audio_file_url":  fileURL,
+		"audio_file_size": 999999999,
+	})
+	if status != 201 {
+		t.Fatalf("create status = %d, body = %v", status, body)
+	}
+
+	if got := totalBytes(); got != float64(len(audio)) {
+		t.Errorf("total_bytes after upload = %v, want %d", got, len(audio))
+	}
+
+	var music models.Music
+	if err := db.First(&music, "title = ?", "Uploaded").Error; err != nil {
+		t.Fatal(err)
+	}
+	if music.AudioFileSize == nil || *music.AudioFileSize != int64(len(audio)) {
+		t.Errorf("audio_file_size = %v, want %d", music.AudioFileSize, len(audio))
+	}
+}
+
+func TestListenerDemographicsHandlerGroupsPlaysByCountry(t *testing.T) {
+	db := testdb.New(t)
+	users := seedUsers(t, db, 4)
+	for i, location := range []string{"Jakarta, Indonesia", "Bandung, ID", "Kuala Lumpur, Malaysia", "Tokyo, Japan"} {
+		if err := db.Model(&users[i]).Update("location", location).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	artist := seedArtist(t, db, "Label Artist", 50)
+	other := seedArtist(t, db, "Other Artist", 60)
+	track := testMusic("Track", int(artist.ID))
+	otherTrack := testMusic("Other Track", int(other.ID))
+	mustCreate(t, db, &track)
+	mustCreate(t, db, &otherTrack)
+
+	play := func(user models.User, music models.Music) models.PlayEvent {
+		return models.PlayEvent{MusicID: music.ID, UserID: user.ID}
+	}
+	mustCreate(t, db, &[]models.PlayEvent{
+		play(users[0], track),
+		play(users[0], track),
+		play(users[0], track),
+		play(users[1], track),
+		play(users[2], track),
+		play(users[2], track),
+		// Play music artist lain dan play di luar periode tidak dihitung
+		play(users[3], otherTrack),
+		{MusicID: track.ID, UserID: users[3].ID, CreatedAt: time.Now().AddDate(0, 0, -40)},
+	})
+
+	app := newTestApp(db, "GET", "/dashboard/listener-demographics", 50, "label", GetListenerDemographicsHandler)
+	status, body := doRequest(t, app, "GET", "/dashboard/listener-demographics?days=30", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	want := []ListenerCountry{
+		{Country: "Indonesia", Plays: 4, Listeners: 2},
+		{Country: "Malaysia", Plays: 2, Listeners: 1},
+	}
+	countries := dataList(t, body)
+	if len(countries) != len(want) {
+		t.Fatalf("countries = %v, want %v", countries, want)
+	}
+	for i, country := range countries {
+		got := ListenerCountry{
+			Country:   country["country"].(string),
+			Plays:     int64(country["plays"].(float64)),
+			Listeners: int64(country["listeners"].(float64)),
+		}
+		if got != want[i] {
+			t.Errorf("country %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+
+	summary, _ := body["summary"].(map[string]interface{})
+	if summary["plays"] != float64(6) || summary["listeners"] != float64(3) {
+		t.Errorf("summary = %v, want 6 plays from 3 listeners", summary)
+	}
+}
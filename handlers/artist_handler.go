@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
 	"fmt"
+	"sort"
 
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
@@ -124,6 +126,7 @@ func CreateArtistHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        order    query     string  false  "Sort order" default(desc)
 // @Param        is_highlight query  int     false  "Filter by highlight status (0 or 1)"
 // @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -166,9 +169,12 @@ func GetArtistsHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	// Sort by created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
@@ -214,7 +220,7 @@ func GetArtistHandler(c *fiber.Ctx, db *gorm.DB) error {
 	id := c.Params("id")
 
 	var artist models.Artist
-	if err := db.First(&artist, id).Error; err != nil {
+	if err := db.Preload("PinnedMusic").First(&artist, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"success": false,
@@ -234,6 +240,160 @@ func GetArtistHandler(c *fiber.Ctx, db *gorm.DB) error {
 	})
 }
 
+// GetArtistMusicsHandler mendapatkan musics milik satu artist dengan pagination
+// @Summary      Get artist musics
+// @Description  Get paginated musics of an artist. Supports the same filters and search as the musics list; the pinned track is listed first
+// @Tags         Artists
+// @Accept       json
+// @Produce      json
+// @Param        id            path      int     true   "Artist ID"
+// @Param        page          query     int     false  "Page number" default(1)
+// @Param        limit         query     int     false  "Items per page (max PAGINATION_MAX_LIMIT)" default(10)
+// @Param        skip_total    query     bool    false  "Skip total count and return has_more only" default(false)
+// @Param        genre         query     string  false  "Filter by genre"
+// @Param        genre_id      query     int     false  "Filter by genre ID (any of the music's assigned genres)"
+// @Param        album_id      query     int     false  "Filter by album ID"
+// @Param        search        query     string  false  "Search by title, artist, or album. Search results are trimmed and omit lyrics"
+// @Param        expand        query     string  false  "Include omitted fields in search results (lyrics)"
+// @Param        sort          query     string  false  "Use editorial to rank by editorial weight of the music and its genre, then play count"
+// @Param        sort_by       query     string  false  "Sort field" default(created_at)
+// @Param        order         query     string  false  "Sort order" default(desc)
+// @Param        is_approved   query     int     false  "Filter by approval status (0 or 1)"
+// @Param        submitted_by  query     string  false  "Filter by submitted_by (artist, label, admin)"
+// @Param        safe          query     bool    false  "Swap explicit tracks to their clean version when available"
+// @Success      200           {object}  map[string]interface{}
+// @Failure      400           {object}  map[string]interface{}
+// @Failure      401           {object}  map[string]interface{}
+// @Failure      404           {object}  map[string]interface{}
+// @Failure      500           {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /artists/{id}/musics [get]
+func GetArtistMusicsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+
+	var artist models.Artist
+	if err := db.Select("id", "pinned_music_id").First(&artist, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Artist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data artist",
+			"error":   err.Error(),
+		})
+	}
+
+	query := db.Model(&models.Music{}).Where("artist_id = ?", artist.ID)
+	if artist.PinnedMusicID != nil {
+		query = query.Order(musicFirstOrder(*artist.PinnedMusicID))
+	}
+
+	return respondMusicList(c, db, applyMusicFilters(c, query))
+}
+
+// ArtistReleaseYear struct untuk jumlah rilis artist per tahun
+type ArtistReleaseYear struct {
+	Year   int   `json:"year"`
+	Musics int64 `json:"musics"`
+	Albums int64 `json:"albums"`
+	Total  int64 `json:"total"`
+}
+
+// GetArtistReleaseYearsHandler mendapatkan tahun-tahun rilis artist
+// @Summary      Get artist release years
+// @Description  Get distinct years in which an artist released musics or albums, with counts per year (newest first)
+// @Tags         Artists
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Artist ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /artists/{id}/release-years [get]
+func GetArtistReleaseYearsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+
+	var artist models.Artist
+	if err := db.Select("id").First(&artist, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Artist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data artist",
+			"error":   err.Error(),
+		})
+	}
+
+	type yearCount struct {
+		Year  int
+		Total int64
+	}
+
+	var musicYears []yearCount
+	if err := db.Model(&models.Music{}).
+		Select("YEAR(release_date) AS year, COUNT(*) AS total").
+		Where("artist_id = ? AND release_date IS NOT NULL", artist.ID).
+		Group("year").
+		Scan(&musicYears).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil tahun rilis musics",
+			"error":   err.Error(),
+		})
+	}
+
+	var albumYears []yearCount
+	if err := db.Model(&models.Album{}).
+		Select("YEAR(release_date) AS year, COUNT(*) AS total").
+		Where("artist_id = ? AND release_date IS NOT NULL", artist.ID).
+		Group("year").
+		Scan(&albumYears).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil tahun rilis albums",
+			"error":   err.Error(),
+		})
+	}
+
+	// Gabungkan hitungan musics dan albums per tahun
+	byYear := make(map[int]*ArtistReleaseYear)
+	for _, row := range musicYears {
+		if _, ok := byYear[row.Year]; !ok {
+			byYear[row.Year] = &ArtistReleaseYear{Year: row.Year}
+		}
+		byYear[row.Year].Musics += row.Total
+	}
+	for _, row := range albumYears {
+		if _, ok := byYear[row.Year]; !ok {
+			byYear[row.Year] = &ArtistReleaseYear{Year: row.Year}
+		}
+		byYear[row.Year].Albums += row.Total
+	}
+
+	years := make([]ArtistReleaseYear, 0, len(byYear))
+	for _, year := range byYear {
+		year.Total = year.Musics + year.Albums
+		years = append(years, *year)
+	}
+	sort.Slice(years, func(i, j int) bool {
+		return years[i].Year > years[j].Year
+	})
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    years,
+	})
+}
+
 // UpdateArtistHandler mengupdate artist
 // @Summary      Update artist
 // @Description  Update artist information
@@ -653,3 +813,165 @@ func HighlightArtistHandler(c *fiber.Ctx, db *gorm.DB) error {
 		"data":    artist,
 	})
 }
+
+// PinArtistMusicRequest struct untuk request pin music di halaman artist
+type PinArtistMusicRequest struct {
+	MusicID uint `json:"music_id" validate:"required"`
+}
+
+// canManageArtist mengecek apakah user adalah pemilik artist (ref_user_id) atau admin
+func canManageArtist(c *fiber.Ctx, artist models.Artist) bool {
+	userID := c.Locals("user_id").(uint)
+	role := c.Locals("role").(string)
+
+	isOwner := artist.RefUserID != nil && *artist.RefUserID == userID
+	return isOwner || role == string(models.RoleAdmin)
+}
+
+// PinArtistMusicHandler menyematkan music di halaman artist
+// @Summary      Pin music on artist page
+// @Description  Pin one of the artist's own tracks to the top of the artist page (owner or admin only)
+// @Tags         Artists
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                    true  "Artist ID"
+// @Param        request  body      PinArtistMusicRequest  true  "Pin Music Request"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /artists/{id}/pin [put]
+func PinArtistMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
+	var req PinArtistMusicRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal parse request body",
+			"error":   err.Error(),
+		})
+	}
+	if req.MusicID == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "music_id wajib diisi",
+		})
+	}
+
+	var artist models.Artist
+	if err := db.First(&artist, c.Params("id")).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Artist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data artist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Hanya artist pemilik atau admin yang boleh mengubah music yang disematkan
+	if !canManageArtist(c, artist) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Anda tidak memiliki akses untuk mengubah artist ini",
+		})
+	}
+
+	var music models.Music
+	if err := db.First(&music, req.MusicID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Music tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data music",
+			"error":   err.Error(),
+		})
+	}
+
+	// Music harus milik artist ini
+	if music.ArtistID != int(artist.ID) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Music bukan milik artist ini",
+		})
+	}
+
+	if err := db.Model(&artist).Update("pinned_music_id", music.ID).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menyematkan music",
+			"error":   err.Error(),
+		})
+	}
+	artist.PinnedMusic = &music
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Music berhasil disematkan",
+		"data":    artist,
+	})
+}
+
+// UnpinArtistMusicHandler melepas music yang disematkan di halaman artist
+// @Summary      Unpin music from artist page
+// @Description  Remove the pinned track from the artist page (owner or admin only)
+// @Tags         Artists
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Artist ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /artists/{id}/pin [delete]
+func UnpinArtistMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
+	var artist models.Artist
+	if err := db.First(&artist, c.Params("id")).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Artist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data artist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Hanya artist pemilik atau admin yang boleh mengubah music yang disematkan
+	if !canManageArtist(c, artist) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Anda tidak memiliki akses untuk mengubah artist ini",
+		})
+	}
+
+	if err := db.Model(&artist).Update("pinned_music_id", nil).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal melepas music yang disematkan",
+			"error":   err.Error(),
+		})
+	}
+	artist.PinnedMusicID = nil
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Music berhasil dilepas dari halaman artist",
+		"data":    artist,
+	})
+}
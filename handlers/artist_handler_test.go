@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// seedArtist membuat artist minimal yang valid; refUserID 0 berarti tanpa pemilik
+func seedArtist(t *testing.T, db *gorm.DB, name string, refUserID uint) models.Artist {
+	t.Helper()
+
+	artist := models.Artist{Name: name, Bio: name, Email: name + "@example.com"}
+	if refUserID != 0 {
+		artist.RefUserID = &refUserID
+	}
+	mustCreate(t, db, &artist)
+	return artist
+}
+
+// musicTitles mengambil judul music dari response list
+func musicTitles(t *testing.T, body map[string]interface{}) []string {
+	t.Helper()
+
+	var titles []string
+	for _, music := range dataList(t, body) {
+		titles = append(titles, music["title"].(string))
+	}
+	return titles
+}
+
+func TestPinArtistMusicHandler(t *testing.T) {
+	db := testdb.New(t)
+	artist := seedArtist(t, db, "Owner", 10)
+	other := seedArtist(t, db, "Other", 20)
+
+	musics := []models.Music{
+		testMusic("A Song", int(artist.ID)),
+		testMusic("B Song", int(artist.ID)),
+		testMusic("C Song", int(artist.ID)),
+		testMusic("Other Song", int(other.ID)),
+	}
+	mustCreate(t, db, &musics)
+	pinned, otherTrack := musics[2], musics[3]
+
+	pinPath := fmt.Sprintf("/artists/%d/pin", artist.ID)
+	ownerApp := newTestApp(db, "PUT", "/artists/:id/pin", 10, "independent", PinArtistMusicHandler)
+
+	// Music milik artist lain ditolak
+	if status, body := doRequest(t, ownerApp, "PUT", pinPath, map[string]interface{}{"music_id": otherTrack.ID}); status != 400 {
+		t.Errorf("pin other artist's track status = %d, want 400 (body = %v)", status, body)
+	}
+	// User yang bukan pemilik artist ditolak
+	strangerApp := newTestApp(db, "PUT", "/artists/:id/pin", 20, "independent", PinArtistMusicHandler)
+	if status, _ := doRequest(t, strangerApp, "PUT", pinPath, map[string]interface{}{"music_id": pinned.ID}); status != 403 {
+		t.Errorf("pin by non-owner status = %d, want 403", status)
+	}
+
+	if status, body := doRequest(t, ownerApp, "PUT", pinPath, map[string]interface{}{"music_id": pinned.ID}); status != 200 {
+		t.Fatalf("pin status = %d, body = %v", status, body)
+	}
+
+	// Payload halaman artist memuat music yang disematkan
+	artistApp := newTestApp(db, "GET", "/artists/:id", 10, "independent", GetArtistHandler)
+	status, body := doRequest(t, artistApp, "GET", fmt.Sprintf("/artists/%d", artist.ID), nil)
+	if status != 200 {
+		t.Fatalf("get artist status = %d, body = %v", status, body)
+	}
+	pinnedMusic, _ := dataMap(t, body)["pinned_music"].(map[string]interface{})
+	if pinnedMusic == nil || pinnedMusic["title"] != "C Song" {
+		t.Errorf("pinned_music = %v, want C Song", dataMap(t, body)["pinned_music"])
+	}
+
+	// Music yang disematkan tampil paling atas, sisanya mengikuti sort yang diminta
+	listApp := newTestApp(db, "GET", "/musics", 10, "independent", GetMusicsHandler)
+	status, body = doRequest(t, listApp, "GET", fmt.Sprintf("/musics?artist_id=%d&sort_by=title&order=asc", artist.ID), nil)
+	if status != 200 {
+		t.Fatalf("list status = %d, body = %v", status, body)
+	}
+	want := []string{"C Song", "A Song", "B Song"}
+	if got := musicTitles(t, body); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("titles = %v, want %v", got, want)
+	}
+}
+
+func TestGetArtistMusicsHandlerSearchesOnlyArtistCatalog(t *testing.T) {
+	db := testdb.New(t)
+	artist := seedArtist(t, db, "Owner", 10)
+	other := seedArtist(t, db, "Other", 20)
+
+	musics := []models.Music{
+		testMusic("Love Ballad", int(artist.ID)),
+		testMusic("Hate Song", int(artist.ID)),
+		testMusic("Love Song", int(artist.ID)),
+		testMusic("Love Other", int(other.ID)),
+	}
+	mustCreate(t, db, &musics)
+	if err := db.Model(&artist).Update("pinned_music_id", musics[2].ID).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	app := newTestApp(db, "GET", "/artists/:id/musics", 1, "user", GetArtistMusicsHandler)
+	status, body := doRequest(t, app, "GET", fmt.Sprintf("/artists/%d/musics?search=Love&sort_by=title&order=asc", artist.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	// Music yang disematkan tetap paling atas di hasil search
+	want := []string{"Love Song", "Love Ballad"}
+	if got := musicTitles(t, body); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("titles = %v, want %v", got, want)
+	}
+
+	if status, _ := doRequest(t, app, "GET", "/artists/9999/musics?search=Love", nil); status != 404 {
+		t.Errorf("missing artist status = %d, want 404", status)
+	}
+}
+
+func TestGetArtistReleaseYearsHandlerCountsOnlyYearsWithContent(t *testing.T) {
+	db := testdb.New(t)
+	artist := seedArtist(t, db, "Owner", 0)
+	other := seedArtist(t, db, "Other", 0)
+
+	date := func(year int) *time.Time {
+		value := time.Date(year, time.June, 1, 0, 0, 0, 0, time.UTC)
+		return &value
+	}
+	music := func(title string, artistID uint, year int) models.Music {
+		m := testMusic(title, int(artistID))
+		if year != 0 {
+			m.ReleaseDate = date(year)
+		}
+		return m
+	}
+	musics := []models.Music{
+		music("One", artist.ID, 2020),
+		music("Two", artist.ID, 2020),
+		music("Three", artist.ID, 2022),
+		music("Undated", artist.ID, 0),
+		music("Deleted", artist.ID, 2018),
+		music("Elsewhere", other.ID, 2021),
+	}
+	mustCreate(t, db, &musics)
+	if err := db.Delete(&musics[4]).Error; err != nil {
+		t.Fatal(err)
+	}
+	mustCreate(t, db, &[]models.Album{
+		{Title: "Debut", ArtistID: int(artist.ID), Artist: artist.Name, AlbumType: models.AlbumTypeAlbum, ReleaseDate: date(2020)},
+		{Title: "Early EP", ArtistID: int(artist.ID), Artist: artist.Name, AlbumType: models.AlbumTypeAlbum, ReleaseDate: date(2019)},
+	})
+
+	app := newTestApp(db, "GET", "/artists/:id/release-years", 1, "user", GetArtistReleaseYearsHandler)
+	status, body := doRequest(t, app, "GET", fmt.Sprintf("/artists/%d/release-years", artist.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	want := []ArtistReleaseYear{
+		{Year: 2022, Musics: 1, Albums: 0, Total: 1},
+		{Year: 2020, Musics: 2, Albums: 1, Total: 3},
+		{Year: 2019, Musics: 0, Albums: 1, Total: 1},
+	}
+	years := dataList(t, body)
+	if len(years) != len(want) {
+		t.Fatalf("years = %v, want %d entries", years, len(want))
+	}
+	for i, year := range years {
+		got := ArtistReleaseYear{
+			Year:   int(year["year"].(float64)),
+			Musics: int64(year["musics"].(float64)),
+			Albums: int64(year["albums"].(float64)),
+			Total:  int64(year["total"].(float64)),
+		}
+		if got != want[i] {
+			t.Errorf("year %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+
+	if status, _ := doRequest(t, app, "GET", "/artists/9999/release-years", nil); status != 404 {
+		t.Errorf("missing artist status = %d, want 404", status)
+	}
+}
+
+func TestPinnedMusicFollowsMergeAndDelete(t *testing.T) {
+	db := testdb.New(t)
+	artist := seedArtist(t, db, "Owner", 10)
+
+	musics := []models.Music{
+		testMusic("Duplicate", int(artist.ID)),
+		testMusic("Original", int(artist.ID)),
+	}
+	mustCreate(t, db, &musics)
+	source, target := musics[0], musics[1]
+	db.Model(&artist).Update("pinned_music_id", source.ID)
+
+	// Merge memindahkan pin ke music tujuan
+	merge := newTestApp(db, "POST", "/admin/musics/:id/merge/:into_id", 1, "admin", MergeMusicHandler)
+	if status, body := doRequest(t, merge, "POST", fmt.Sprintf("/admin/musics/%d/merge/%d", source.ID, target.ID), nil); status != 200 {
+		t.Fatalf("merge status = %d, body = %v", status, body)
+	}
+	app := newTestApp(db, "GET", "/artists/:id", 1, "user", GetArtistHandler)
+	status, body := doRequest(t, app, "GET", fmt.Sprintf("/artists/%d", artist.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	pinned, _ := dataMap(t, body)["pinned_music"].(map[string]interface{})
+	if pinned == nil || pinned["id"] != float64(target.ID) {
+		t.Errorf("pinned_music after merge = %v, want music %d", pinned, target.ID)
+	}
+
+	// Menghapus music yang di-pin mengosongkan pin
+	remove := newTestApp(db, "DELETE", "/musics/:id", 1, "admin", DeleteMusicHandler)
+	if status, body := doRequest(t, remove, "DELETE", fmt.Sprintf("/musics/%d", target.ID), nil); status != 200 {
+		t.Fatalf("delete status = %d, body = %v", status, body)
+	}
+	var updated models.Artist
+	db.First(&updated, artist.ID)
+	if updated.PinnedMusicID != nil {
+		t.Errorf("pinned_music_id after delete = %d, want nil", *updated.PinnedMusicID)
+	}
+}
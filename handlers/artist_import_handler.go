@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+	"net/mail"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// maxArtistImportRows batas jumlah baris dalam satu kali import
+const maxArtistImportRows = 500
+
+// Status hasil import per baris
+const (
+	ArtistImportCreated   = "created"
+	ArtistImportDuplicate = "duplicate"
+	ArtistImportInvalid   = "invalid"
+)
+
+// ArtistImportResult struct untuk hasil import satu baris artist
+type ArtistImportResult struct {
+	Row      int    `json:"row"` // Dimulai dari 1 sesuai urutan array request
+	Status   string `json:"status"`
+	Message  string `json:"message,omitempty"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	ArtistID *uint  `json:"artist_id,omitempty"`
+}
+
+// normalizeArtistName menyamakan nama artist untuk pengecekan duplikat (huruf kecil, spasi dirapikan)
+func normalizeArtistName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// validateArtistImportRow mengembalikan pesan error jika data artist tidak valid
+func validateArtistImportRow(req CreateArtistRequest) string {
+	if strings.TrimSpace(req.Name) == "" {
+		return "name wajib diisi"
+	}
+	if strings.TrimSpace(req.Bio) == "" {
+		return "bio wajib diisi"
+	}
+	if strings.TrimSpace(req.Email) == "" {
+		return "email wajib diisi"
+	}
+	if _, err := mail.ParseAddress(req.Email); err != nil {
+		return "format email tidak valid"
+	}
+	if len(req.DebutYear) != 4 {
+		return "debut_year harus 4 digit"
+	}
+	for _, r := range req.DebutYear {
+		if r < '0' || r > '9' {
+			return "debut_year harus 4 digit"
+		}
+	}
+	return ""
+}
+
+// ImportArtistsHandler membuat banyak artist sekaligus
+// @Summary      Import artists
+// @Description  Bulk create artists (label or admin only). Rows are validated, deduplicated on email and normalized name against existing artists and earlier rows, and valid new rows are inserted in one transaction. Each row reports created, duplicate or invalid
+// @Tags         Artists
+// @Accept       json
+// @Produce      json
+// @Param        request  body      []CreateArtistRequest  true  "Artists to import"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /artists/import [post]
+func ImportArtistsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	role := c.Locals("role").(string)
+	if role != string(models.RoleLabel) && role != string(models.RoleAdmin) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Hanya label atau admin yang dapat mengimport artist",
+		})
+	}
+
+	var rows []CreateArtistRequest
+	if err := c.BodyParser(&rows); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal parse request body",
+			"error":   err.Error(),
+		})
+	}
+	if len(rows) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Data artist tidak boleh kosong",
+		})
+	}
+	if len(rows) > maxArtistImportRows {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Maksimal 500 artist dalam satu kali import",
+		})
+	}
+
+	// Kumpulkan email untuk dicek ke database dalam satu query
+	emails := make([]string, 0, len(rows))
+	for i := range rows {
+		rows[i].Email = strings.ToLower(strings.TrimSpace(rows[i].Email))
+		rows[i].Name = strings.TrimSpace(rows[i].Name)
+		emails = append(emails, rows[i].Email)
+	}
+
+	var existingEmails []string
+	if err := db.Model(&models.Artist{}).Where("LOWER(email) IN ?", emails).Pluck("email", &existingEmails).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data artist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Nama dibandingkan setelah normalizeArtistName di Go (termasuk spasi di tengah nama),
+	// sehingga nama artist yang ada tidak bisa difilter lewat SQL
+	var existingNames []string
+	if err := db.Model(&models.Artist{}).Pluck("name", &existingNames).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data artist",
+			"error":   err.Error(),
+		})
+	}
+
+	seenEmails := make(map[string]bool)
+	seenNames := make(map[string]bool)
+	for _, email := range existingEmails {
+		seenEmails[strings.ToLower(email)] = true
+	}
+	for _, name := range existingNames {
+		seenNames[normalizeArtistName(name)] = true
+	}
+
+	results := make([]ArtistImportResult, len(rows))
+	var toCreate []int
+	for i, req := range rows {
+		results[i] = ArtistImportResult{
+			Row:   i + 1,
+			Name:  req.Name,
+			Email: req.Email,
+		}
+
+		if message := validateArtistImportRow(req); message != "" {
+			results[i].Status = ArtistImportInvalid
+			results[i].Message = message
+			continue
+		}
+
+		normalizedName := normalizeArtistName(req.Name)
+		if seenEmails[req.Email] {
+			results[i].Status = ArtistImportDuplicate
+			results[i].Message = "Email sudah terdaftar"
+			continue
+		}
+		if seenNames[normalizedName] {
+			results[i].Status = ArtistImportDuplicate
+			results[i].Message = "Nama artist sudah terdaftar"
+			continue
+		}
+
+		seenEmails[req.Email] = true
+		seenNames[normalizedName] = true
+		toCreate = append(toCreate, i)
+	}
+
+	// Mulai transaksi
+	tx := db.Begin()
+
+	for _, i := range toCreate {
+		req := rows[i]
+
+		var socialMedia models.JSONB
+		if req.SocialMedia != nil {
+			socialMedia = models.JSONB(req.SocialMedia)
+		}
+
+		artist := models.Artist{
+			Name:         req.Name,
+			Bio:          req.Bio,
+			Genre:        req.Genre,
+			Country:      req.Country,
+			DebutYear:    req.DebutYear,
+			Website:      req.Website,
+			Email:        req.Email,
+			Phone:        req.Phone,
+			SocialMedia:  socialMedia,
+			ProfileImage: req.ProfileImage,
+			CoverImage:   req.CoverImage,
+			Photo:        req.Photo,
+		}
+
+		if err := tx.Create(&artist).Error; err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengimport artist",
+				"error":   err.Error(),
+				"row":     i + 1,
+			})
+		}
+
+		artistID := artist.ID
+		results[i].Status = ArtistImportCreated
+		results[i].ArtistID = &artistID
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengimport artist",
+			"error":   err.Error(),
+		})
+	}
+
+	summary := map[string]int{
+		ArtistImportCreated:   0,
+		ArtistImportDuplicate: 0,
+		ArtistImportInvalid:   0,
+	}
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Import artist selesai",
+		"data":    results,
+		"summary": summary,
+	})
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"testing"
+)
+
+func TestImportArtistsHandlerReportsEachRow(t *testing.T) {
+	db := testdb.New(t)
+	seedArtist(t, db, "Existing", 0)
+	seedArtist(t, db, "Foo  Bar", 0) // Spasi ganda di nama yang sudah ada
+
+	row := func(name, email, debutYear string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":       name,
+			"bio":        "bio",
+			"email":      email,
+			"debut_year": debutYear,
+		}
+	}
+	rows := []map[string]interface{}{
+		row("The Band", "band@example.com", "2020"),
+		row("Someone", "EXISTING@example.com", "2019"),
+		row("  the   BAND ", "other@example.com", "2018"),
+		row("No Email", "not-an-email", "2017"),
+		row("Bad Year", "bad@example.com", "20x1"),
+		row("Solo", "solo@example.com", "2021"),
+		row("Foo Bar", "foo@example.com", "2016"),
+	}
+
+	if status, _ := doRequest(t, newTestApp(db, "POST", "/artists/import", 1, "user", ImportArtistsHandler), "POST", "/artists/import", rows); status != 403 {
+		t.Errorf("user import status = %d, want 403", status)
+	}
+
+	app := newTestApp(db, "POST", "/artists/import", 1, "label", ImportArtistsHandler)
+	status, body := doRequest(t, app, "POST", "/artists/import", rows)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	want := []string{
+		ArtistImportCreated,
+		ArtistImportDuplicate,
+		ArtistImportDuplicate,
+		ArtistImportInvalid,
+		ArtistImportInvalid,
+		ArtistImportCreated,
+		ArtistImportDuplicate,
+	}
+	results := dataList(t, body)
+	if len(results) != len(want) {
+		t.Fatalf("got %d results, want %d: %v", len(results), len(want), results)
+	}
+	for i, result := range results {
+		if result["row"] != float64(i+1) || result["status"] != want[i] {
+			t.Errorf("row %d = %v, want status %s", i+1, result, want[i])
+		}
+	}
+
+	summary, _ := body["summary"].(map[string]interface{})
+	if summary["created"] != float64(2) || summary["duplicate"] != float64(3) || summary["invalid"] != float64(2) {
+		t.Errorf("summary = %v, want 2 created, 3 duplicate, 2 invalid", summary)
+	}
+
+	var count int64
+	if err := db.Model(&models.Artist{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Errorf("artists = %d, want 4 (2 existing + 2 created)", count)
+	}
+}
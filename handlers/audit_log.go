@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// recordAuditLog mencatat aksi admin; details disimpan sebagai JSON
+func recordAuditLog(c *fiber.Ctx, db *gorm.DB, action, entityType string, entityID uint, details interface{}) error {
+	userID, _ := c.Locals("user_id").(uint)
+
+	log := models.AuditLog{
+		UserID:     userID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+	}
+	if details != nil {
+		encoded, err := json.Marshal(details)
+		if err != nil {
+			return err
+		}
+		detailsStr := string(encoded)
+		log.Details = &detailsStr
+	}
+
+	return db.Create(&log).Error
+}
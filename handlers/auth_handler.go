@@ -3,7 +3,6 @@ package handlers
 import (
 	"backend_soundcave/models"
 	"backend_soundcave/utils"
-	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -222,7 +221,7 @@ func LoginHandler(c *fiber.Ctx, db *gorm.DB) error {
 	}
 
 	// Generate JWT token
-	token, err := utils.GenerateToken(user.ID, user.Email, string(user.Role))
+	token, err := utils.GenerateToken(user.ID, user.Email, string(user.Role), user.TokenVersion)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -290,7 +289,7 @@ func GoogleAuthHandler(c *fiber.Ctx, db *gorm.DB) error {
 	}
 
 	// Validasi ID token dengan Firebase
-	ctx := context.Background()
+	ctx := c.UserContext()
 	audience := os.Getenv("FIREBASE_AUDIENCE")
 	if audience == "" {
 		// Default audience untuk Firebase
@@ -372,7 +371,7 @@ func GoogleAuthHandler(c *fiber.Ctx, db *gorm.DB) error {
 	}
 
 	// Generate JWT token
-	token, err := utils.GenerateToken(user.ID, user.Email, string(user.Role))
+	token, err := utils.GenerateToken(user.ID, user.Email, string(user.Role), user.TokenVersion)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
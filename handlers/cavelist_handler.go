@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
 	"strconv"
 	"time"
 
@@ -123,6 +124,16 @@ func CreateCavelistHandler(c *fiber.Ctx, db *gorm.DB) error {
 		}
 	}
 
+	// Ukuran file diambil dari catatan upload, bukan dari client
+	videoFileSize, err := uploadedFileSize(db, req.VideoURL)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil ukuran file video",
+			"error":   err.Error(),
+		})
+	}
+
 	// Buat cavelist baru
 	cavelist := models.Cavelist{
 		Title:           req.Title,
@@ -133,6 +144,7 @@ func CreateCavelistHandler(c *fiber.Ctx, db *gorm.DB) error {
 		Likes:           &likes,
 		Shares:          &shares,
 		VideoURL:        req.VideoURL,
+		VideoFileSize:   videoFileSize,
 		ArtistID:        req.ArtistID,
 		ArtistName:      req.ArtistName,
 		Status:          status,
@@ -169,6 +181,7 @@ func CreateCavelistHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        sort_by      query     string  false  "Sort field" default(created_at)
 // @Param        order        query     string  false  "Sort order" default(desc)
 // @Success      200          {object}  map[string]interface{}
+// @Failure      400          {object}  map[string]interface{}
 // @Failure      401          {object}  map[string]interface{}
 // @Failure      500          {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -213,9 +226,12 @@ func GetCavelistsHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	// Sort
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
@@ -283,6 +299,7 @@ func GetCavelistByIDHandler(c *fiber.Ctx, db *gorm.DB) error {
 		cavelist.Viewers = &viewers
 	}
 	db.Save(&cavelist)
+	recordCavelistEvent(c, db, cavelist.ID, models.CavelistEventView)
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
@@ -358,6 +375,15 @@ func UpdateCavelistHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	if req.VideoURL != nil {
 		cavelist.VideoURL = *req.VideoURL
+		videoFileSize, err := uploadedFileSize(db, *req.VideoURL)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil ukuran file video",
+				"error":   err.Error(),
+			})
+		}
+		cavelist.VideoFileSize = videoFileSize
 	}
 
 	if req.ArtistID != nil {
@@ -493,6 +519,7 @@ func IncrementCavelistLikesHandler(c *fiber.Ctx, db *gorm.DB) error {
 			"error":   err.Error(),
 		})
 	}
+	recordCavelistEvent(c, db, cavelist.ID, models.CavelistEventLike)
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
@@ -547,6 +574,7 @@ func IncrementCavelistSharesHandler(c *fiber.Ctx, db *gorm.DB) error {
 			"error":   err.Error(),
 		})
 	}
+	recordCavelistEvent(c, db, cavelist.ID, models.CavelistEventShare)
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
@@ -554,3 +582,117 @@ func IncrementCavelistSharesHandler(c *fiber.Ctx, db *gorm.DB) error {
 		"data":    cavelist,
 	})
 }
+
+// CavelistDailyStats struct untuk agregasi engagement cavelist per hari
+type CavelistDailyStats struct {
+	Date   string `json:"date"`
+	Views  int64  `json:"views"`
+	Likes  int64  `json:"likes"`
+	Shares int64  `json:"shares"`
+}
+
+// recordCavelistEvent mencatat event cavelist untuk kebutuhan statistik.
+// Kegagalan pencatatan tidak membatalkan request karena counter utama sudah tersimpan.
+func recordCavelistEvent(c *fiber.Ctx, db *gorm.DB, cavelistID uint, eventType models.CavelistEventType) {
+	userID, _ := c.Locals("user_id").(uint)
+	db.Create(&models.CavelistEvent{
+		CavelistID: cavelistID,
+		UserID:     userID,
+		Type:       eventType,
+	})
+}
+
+// GetCavelistStatsHandler mendapatkan engagement harian cavelist
+// @Summary      Get cavelist stats
+// @Description  Get daily views, likes and shares of a cavelist for the last N days
+// @Tags         Cavelists
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int  true   "Cavelist ID"
+// @Param        days  query     int  false  "Number of days (max 365)" default(30)
+// @Success      200   {object}  map[string]interface{}
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /cavelists/{id}/stats [get]
+func GetCavelistStatsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+
+	days := c.QueryInt("days", 30)
+	if days < 1 || days > 365 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "days harus antara 1 dan 365",
+		})
+	}
+
+	var cavelist models.Cavelist
+	if err := db.First(&cavelist, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Cavelist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data cavelist",
+			"error":   err.Error(),
+		})
+	}
+
+	now := time.Now()
+	startDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, -(days - 1))
+
+	var rows []CavelistDailyStats
+	if err := db.Model(&models.CavelistEvent{}).
+		Select("DATE_FORMAT(created_at, '%Y-%m-%d') AS date, "+
+			"SUM(CASE WHEN type = ? THEN 1 ELSE 0 END) AS views, "+
+			"SUM(CASE WHEN type = ? THEN 1 ELSE 0 END) AS likes, "+
+			"SUM(CASE WHEN type = ? THEN 1 ELSE 0 END) AS shares",
+			models.CavelistEventView, models.CavelistEventLike, models.CavelistEventShare).
+		Where("cavelist_id = ? AND created_at >= ?", cavelist.ID, startDate).
+		Group("date").
+		Order("date ASC").
+		Scan(&rows).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil statistik cavelist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Isi hari tanpa event dengan nol agar bucket selalu lengkap
+	byDate := make(map[string]CavelistDailyStats, len(rows))
+	for _, row := range rows {
+		byDate[row.Date] = row
+	}
+
+	stats := make([]CavelistDailyStats, 0, days)
+	var totalViews, totalLikes, totalShares int64
+	for i := 0; i < days; i++ {
+		date := startDate.AddDate(0, 0, i).Format("2006-01-02")
+		bucket, ok := byDate[date]
+		if !ok {
+			bucket = CavelistDailyStats{Date: date}
+		}
+		totalViews += bucket.Views
+		totalLikes += bucket.Likes
+		totalShares += bucket.Shares
+		stats = append(stats, bucket)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    stats,
+		"summary": fiber.Map{
+			"cavelist_id": cavelist.ID,
+			"days":        days,
+			"views":       totalViews,
+			"likes":       totalLikes,
+			"shares":      totalShares,
+		},
+	})
+}
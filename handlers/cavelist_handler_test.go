@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestGetCavelistStatsHandlerBucketsEventsPerDay(t *testing.T) {
+	db := testdb.New(t)
+
+	cavelist := models.Cavelist{Title: "Weekly", VideoURL: "https://example.com/weekly.mp4", ArtistID: 1, ArtistName: "Artist"}
+	other := models.Cavelist{Title: "Other", VideoURL: "https://example.com/other.mp4", ArtistID: 1, ArtistName: "Artist"}
+	mustCreate(t, db, &cavelist)
+	mustCreate(t, db, &other)
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 1, 0, 0, 0, now.Location())
+	yesterday := today.AddDate(0, 0, -1)
+	event := func(cavelistID uint, eventType models.CavelistEventType, at time.Time) models.CavelistEvent {
+		return models.CavelistEvent{CavelistID: cavelistID, UserID: 1, Type: eventType, CreatedAt: at}
+	}
+	mustCreate(t, db, &[]models.CavelistEvent{
+		event(cavelist.ID, models.CavelistEventView, today),
+		event(cavelist.ID, models.CavelistEventView, today),
+		event(cavelist.ID, models.CavelistEventLike, today),
+		event(cavelist.ID, models.CavelistEventView, yesterday),
+		event(cavelist.ID, models.CavelistEventShare, yesterday),
+		// Di luar rentang days=3
+		event(cavelist.ID, models.CavelistEventView, today.AddDate(0, 0, -10)),
+		// Milik cavelist lain
+		event(other.ID, models.CavelistEventLike, today),
+	})
+
+	app := newTestApp(db, "GET", "/cavelists/:id/stats", 1, "admin", GetCavelistStatsHandler)
+	status, body := doRequest(t, app, "GET", fmt.Sprintf("/cavelists/%d/stats?days=3", cavelist.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	want := []struct {
+		date                 time.Time
+		views, likes, shares float64
+	}{
+		{date: today.AddDate(0, 0, -2)},
+		{date: yesterday, views: 1, shares: 1},
+		{date: today, views: 2, likes: 1},
+	}
+	buckets := dataList(t, body)
+	if len(buckets) != len(want) {
+		t.Fatalf("got %d buckets, want %d: %v", len(buckets), len(want), buckets)
+	}
+	for i, w := range want {
+		got := buckets[i]
+		if got["date"] != w.date.Format("2006-01-02") {
+			t.Errorf("bucket[%d].date = %v, want %s", i, got["date"], w.date.Format("2006-01-02"))
+		}
+		if got["views"] != w.views || got["likes"] != w.likes || got["shares"] != w.shares {
+			t.Errorf("bucket[%d] = %v, want views=%v likes=%v shares=%v", i, got, w.views, w.likes, w.shares)
+		}
+	}
+
+	summary := body["summary"].(map[string]interface{})
+	if summary["views"] != float64(3) || summary["likes"] != float64(1) || summary["shares"] != float64(1) {
+		t.Errorf("summary = %v, want views=3 likes=1 shares=1", summary)
+	}
+}
+
+func TestIncrementCavelistLikesHandlerRecordsEvent(t *testing.T) {
+	db := testdb.New(t)
+
+	cavelist := models.Cavelist{Title: "Weekly", VideoURL: "https://example.com/weekly.mp4", ArtistID: 1, ArtistName: "Artist"}
+	mustCreate(t, db, &cavelist)
+
+	app := newTestApp(db, "POST", "/cavelists/:id/likes", 7, "user", IncrementCavelistLikesHandler)
+	status, body := doRequest(t, app, "POST", fmt.Sprintf("/cavelists/%d/likes", cavelist.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	var events []models.CavelistEvent
+	db.Find(&events)
+	if len(events) != 1 || events[0].Type != models.CavelistEventLike || events[0].UserID != 7 {
+		t.Errorf("events = %+v, want one like event from user 7", events)
+	}
+}
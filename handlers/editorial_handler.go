@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// editorialScoreOrder mengurutkan music berdasarkan bobot editorial music ditambah bobot editorial genre-nya
+const editorialScoreOrder = "(musics.editorial_weight + COALESCE((SELECT genres.editorial_weight FROM genres WHERE genres.name = musics.genre AND genres.deleted_at IS NULL LIMIT 1), 0)) DESC"
+
+// UpdateEditorialWeightRequest struct untuk request update bobot editorial
+type UpdateEditorialWeightRequest struct {
+	EditorialWeight *int `json:"editorial_weight" validate:"required"`
+}
+
+// UpdateMusicEditorialWeightHandler mengubah bobot editorial music (admin)
+// @Summary      Update music editorial weight
+// @Description  Set the editorial weight used to boost a music track under ?sort=editorial (admin only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                           true  "Music ID"
+// @Param        request  body      UpdateEditorialWeightRequest  true  "Editorial weight"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /admin/musics/{id}/editorial-weight [put]
+func UpdateMusicEditorialWeightHandler(c *fiber.Ctx, db *gorm.DB) error {
+	var req UpdateEditorialWeightRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal parse request body",
+			"error":   err.Error(),
+		})
+	}
+	if req.EditorialWeight == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "editorial_weight wajib diisi",
+		})
+	}
+
+	var music models.Music
+	if err := db.First(&music, c.Params("id")).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Music tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data music",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := db.Model(&music).Update("editorial_weight", *req.EditorialWeight).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate bobot editorial music",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Bobot editorial music berhasil diupdate",
+		"data":    music,
+	})
+}
+
+// UpdateGenreEditorialWeightHandler mengubah bobot editorial genre (admin)
+// @Summary      Update genre editorial weight
+// @Description  Set the editorial weight added to every music of a genre under ?sort=editorial (admin only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                           true  "Genre ID"
+// @Param        request  body      UpdateEditorialWeightRequest  true  "Editorial weight"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /admin/genres/{id}/editorial-weight [put]
+func UpdateGenreEditorialWeightHandler(c *fiber.Ctx, db *gorm.DB) error {
+	var req UpdateEditorialWeightRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal parse request body",
+			"error":   err.Error(),
+		})
+	}
+	if req.EditorialWeight == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "editorial_weight wajib diisi",
+		})
+	}
+
+	var genre models.Genre
+	if err := db.First(&genre, c.Params("id")).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Genre tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data genre",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := db.Model(&genre).Update("editorial_weight", *req.EditorialWeight).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate bobot editorial genre",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Bobot editorial genre berhasil diupdate",
+		"data":    genre,
+	})
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"testing"
+)
+
+func TestEditorialSortRanksBoostedTracksFirst(t *testing.T) {
+	db := testdb.New(t)
+	mustCreate(t, db, &models.Genre{Name: "Jazz", Description: "Jazz", EditorialWeight: 5})
+
+	popular := testMusic("Popular", 1)
+	popular.PlayCount = intPtr(1000)
+	boosted := testMusic("Boosted", 1)
+	boosted.PlayCount = intPtr(1)
+	jazz := testMusic("Jazz Track", 1)
+	jazz.Genre = "Jazz"
+	jazz.PlayCount = intPtr(10)
+	mustCreate(t, db, &[]models.Music{popular, boosted, jazz})
+
+	var boostedID uint
+	db.Model(&models.Music{}).Where("title = ?", "Boosted").Pluck("id", &boostedID)
+
+	weightApp := newTestApp(db, "PUT", "/admin/musics/:id/editorial-weight", 1, "admin", UpdateMusicEditorialWeightHandler)
+	if status, body := doRequest(t, weightApp, "PUT", fmt.Sprintf("/admin/musics/%d/editorial-weight", boostedID), map[string]interface{}{
+		"editorial_weight": 10,
+	}); status != 200 {
+		t.Fatalf("weight status = %d, body = %v", status, body)
+	}
+
+	app := newTestApp(db, "GET", "/musics", 1, "user", GetMusicsHandler)
+	status, body := doRequest(t, app, "GET", "/musics?sort=editorial", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	// Bobot music 10 > bobot genre 5 > tanpa bobot, meskipun play count lebih kecil
+	want := []string{"Boosted", "Jazz Track", "Popular"}
+	if got := musicTitles(t, body); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("editorial titles = %v, want %v", got, want)
+	}
+
+	if status, _ := doRequest(t, app, "GET", "/musics?sort=popular", nil); status != 400 {
+		t.Errorf("unknown sort status = %d, want 400", status)
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
 	"regexp"
 
 	"github.com/gofiber/fiber/v2"
@@ -111,6 +112,7 @@ func CreateGenreHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        sort_by  query     string  false  "Sort field" default(created_at)
 // @Param        order    query     string  false  "Sort order" default(desc)
 // @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -133,9 +135,12 @@ func GetGenresHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	// Sort by name atau created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"testing"
+)
+
+func TestGetGenresHandlerOrder(t *testing.T) {
+	db := testdb.New(t)
+	mustCreate(t, db, &[]models.Genre{
+		{Name: "Jazz", Description: "Jazz"},
+		{Name: "Ambient", Description: "Ambient"},
+		{Name: "Metal", Description: "Metal"},
+	})
+
+	app := newTestApp(db, "GET", "/genres", 1, "user", GetGenresHandler)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "default is desc", query: "?sort_by=name", want: []string{"Metal", "Jazz", "Ambient"}},
+		{name: "explicit asc", query: "?sort_by=name&order=asc", want: []string{"Ambient", "Jazz", "Metal"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, app, "GET", "/genres"+tt.query, nil)
+			if status != 200 {
+				t.Fatalf("status = %d, body = %v", status, body)
+			}
+			genres := dataList(t, body)
+			if len(genres) != len(tt.want) {
+				t.Fatalf("got %d genres, want %d", len(genres), len(tt.want))
+			}
+			for i, name := range tt.want {
+				if genres[i]["name"] != name {
+					t.Errorf("genres[%d] = %v, want %s", i, genres[i]["name"], name)
+				}
+			}
+		})
+	}
+}
+
+func TestListHandlersRejectInvalidOrder(t *testing.T) {
+	db := testdb.New(t)
+
+	handlers := map[string]testHandler{
+		"genres":   GetGenresHandler,
+		"podcasts": GetPodcastsHandler,
+		"news":     GetNewsHandler,
+	}
+	for name, handler := range handlers {
+		t.Run(name, func(t *testing.T) {
+			app := newTestApp(db, "GET", "/list", 1, "user", handler)
+			status, body := doRequest(t, app, "GET", "/list?order=sideways", nil)
+			if status != 400 {
+				t.Errorf("status = %d, want 400 (body = %v)", status, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// testHandler adalah signature handler yang dipakai di seluruh package
+type testHandler func(c *fiber.Ctx, db *gorm.DB) error
+
+// newTestApp membuat app dengan satu route; user_id dan role di-set seperti AuthMiddleware
+func newTestApp(db *gorm.DB, method, path string, userID uint, role string, handler testHandler) *fiber.App {
+	app := fiber.New()
+	app.Add(method, path, func(c *fiber.Ctx) error {
+		if userID != 0 {
+			c.Locals("user_id", userID)
+			c.Locals("role", role)
+		}
+		return handler(c, db)
+	})
+	return app
+}
+
+// doRequest mengirim request JSON ke app dan mengembalikan status beserta body yang sudah di-decode
+func doRequest(t *testing.T, app *fiber.App, method, target string, body interface{}) (int, map[string]interface{}) {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("gagal encode body: %v", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req := httptest.NewRequest(method, target, reader)
+	req.Header.Set("Content-Type", "application/json")
+	return sendRequest(t, app, req)
+}
+
+// sendRequest menjalankan request yang sudah dibuat dan men-decode response JSON
+func sendRequest(t *testing.T, app *fiber.App, req *http.Request) (int, map[string]interface{}) {
+	t.Helper()
+
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatalf("request gagal: %v", err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("gagal membaca response: %v", err)
+	}
+
+	result := map[string]interface{}{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &result); err != nil {
+			t.Fatalf("response bukan JSON: %s", raw)
+		}
+	}
+	return resp.StatusCode, result
+}
+
+// mustCreate menyimpan data seed dan menggagalkan test jika error
+func mustCreate(t *testing.T, db *gorm.DB, value interface{}) {
+	t.Helper()
+	if err := db.Create(value).Error; err != nil {
+		t.Fatalf("gagal seed data: %v", err)
+	}
+}
+
+// dataList mengambil field "data" response sebagai list object
+func dataList(t *testing.T, body map[string]interface{}) []map[string]interface{} {
+	t.Helper()
+
+	items, ok := body["data"].([]interface{})
+	if !ok {
+		t.Fatalf("data bukan list: %#v", body["data"])
+	}
+	result := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		result = append(result, item.(map[string]interface{}))
+	}
+	return result
+}
+
+// dataMap mengambil field "data" response sebagai object
+func dataMap(t *testing.T, body map[string]interface{}) map[string]interface{} {
+	t.Helper()
+
+	data, ok := body["data"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("data bukan object: %#v", body["data"])
+	}
+	return data
+}
+
+// intPtr mengembalikan pointer ke nilai int
+func intPtr(value int) *int {
+	return &value
+}
+
+// boolPtr mengembalikan pointer ke nilai bool
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+// seedUsers membuat user dengan ID berurutan mulai dari 1
+func seedUsers(t *testing.T, db *gorm.DB, count int) []models.User {
+	t.Helper()
+
+	users := make([]models.User, 0, count)
+	for i := 1; i <= count; i++ {
+		users = append(users, models.User{FullName: fmt.Sprintf("User %d", i), Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+	mustCreate(t, db, &users)
+	return users
+}
+
+// testMusic membuat data music minimal yang valid untuk di-seed
+func testMusic(title string, artistID int) models.Music {
+	return models.Music{
+		Title:        title,
+		Artist:       "Artist",
+		ArtistID:     artistID,
+		Genre:        "Pop",
+		Duration:     "03:30",
+		Language:     "Indonesian",
+		AudioFileURL: "https://example.com/" + title + ".mp3",
+	}
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// recordPlayEvent mencatat play music; kegagalan tidak menggagalkan request
+func recordPlayEvent(c *fiber.Ctx, db *gorm.DB, musicID uint) {
+	userID, _ := c.Locals("user_id").(uint)
+	db.Create(&models.PlayEvent{
+		MusicID: musicID,
+		UserID:  userID,
+	})
+}
+
+// ResetMusicCountsHandler mereset play count dan like count music (admin)
+// @Summary      Reset music counts
+// @Description  Reset play_count and like_count of a music track to zero. Optionally clear its play events, and its likes so like_count stays in sync with music_likes (this removes the track from listeners' liked songs). The action is recorded in the audit log (admin only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id            path      int   true   "Music ID"
+// @Param        clear_events  query     bool  false  "Also delete recorded play events" default(false)
+// @Param        clear_likes   query     bool  false  "Also delete users' likes of the track" default(false)
+// @Success      200           {object}  map[string]interface{}
+// @Failure      401           {object}  map[string]interface{}
+// @Failure      403           {object}  map[string]interface{}
+// @Failure      404           {object}  map[string]interface{}
+// @Failure      500           {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /admin/musics/{id}/reset-counts [post]
+func ResetMusicCountsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+	clearEvents := c.QueryBool("clear_events", false)
+	clearLikes := c.QueryBool("clear_likes", false)
+
+	// Mulai transaksi
+	tx := db.Begin()
+
+	var music models.Music
+	if err := tx.First(&music, id).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Music tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data music",
+			"error":   err.Error(),
+		})
+	}
+
+	previousPlayCount := 0
+	previousLikeCount := 0
+	if music.PlayCount != nil {
+		previousPlayCount = *music.PlayCount
+	}
+	if music.LikeCount != nil {
+		previousLikeCount = *music.LikeCount
+	}
+
+	if err := tx.Model(&music).Updates(map[string]interface{}{
+		"play_count": 0,
+		"like_count": 0,
+	}).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mereset counter music",
+			"error":   err.Error(),
+		})
+	}
+
+	// Like user hanya dihapus jika diminta karena ikut menghapus lagu dari liked songs user
+	var deletedLikes int64
+	if clearLikes {
+		result := tx.Where("music_id = ?", music.ID).Delete(&models.MusicLike{})
+		if result.Error != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal menghapus likes music",
+				"error":   result.Error.Error(),
+			})
+		}
+		deletedLikes = result.RowsAffected
+	}
+
+	var deletedEvents int64
+	if clearEvents {
+		result := tx.Where("music_id = ?", music.ID).Delete(&models.PlayEvent{})
+		if result.Error != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal menghapus play events",
+				"error":   result.Error.Error(),
+			})
+		}
+		deletedEvents = result.RowsAffected
+	}
+
+	if err := recordAuditLog(c, tx, "music.reset_counts", "music", music.ID, fiber.Map{
+		"previous_play_count": previousPlayCount,
+		"previous_like_count": previousLikeCount,
+		"clear_likes":         clearLikes,
+		"deleted_likes":       deletedLikes,
+		"clear_events":        clearEvents,
+		"deleted_events":      deletedEvents,
+	}); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mencatat audit log",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mereset counter music",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Counter music berhasil direset",
+		"data": fiber.Map{
+			"music_id":            music.ID,
+			"play_count":          0,
+			"like_count":          0,
+			"previous_play_count": previousPlayCount,
+			"previous_like_count": previousLikeCount,
+			"deleted_likes":       deletedLikes,
+			"deleted_events":      deletedEvents,
+		},
+	})
+}
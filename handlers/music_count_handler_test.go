@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestResetMusicCountsHandlerZeroesCountsAndWritesAudit(t *testing.T) {
+	db := testdb.New(t)
+
+	music := testMusic("Inflated", 1)
+	music.PlayCount, music.LikeCount = intPtr(500), intPtr(2)
+	other := testMusic("Untouched", 1)
+	other.PlayCount, other.LikeCount = intPtr(3), intPtr(1)
+	mustCreate(t, db, &music)
+	mustCreate(t, db, &other)
+
+	mustCreate(t, db, &[]models.MusicLike{
+		{UserID: 1, MusicID: music.ID},
+		{UserID: 2, MusicID: music.ID},
+		{UserID: 1, MusicID: other.ID},
+	})
+	mustCreate(t, db, &[]models.PlayEvent{
+		{MusicID: music.ID, UserID: 1},
+		{MusicID: music.ID, UserID: 2},
+		{MusicID: other.ID, UserID: 1},
+	})
+
+	app := newTestApp(db, "POST", "/admin/musics/:id/reset-counts", 9, "admin", ResetMusicCountsHandler)
+	status, body := doRequest(t, app, "POST", fmt.Sprintf("/admin/musics/%d/reset-counts?clear_events=true", music.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	var reset models.Music
+	db.First(&reset, music.ID)
+	if *reset.PlayCount != 0 || *reset.LikeCount != 0 {
+		t.Errorf("counts = play %d like %d, want 0/0", *reset.PlayCount, *reset.LikeCount)
+	}
+
+	// Like user tidak dihapus tanpa clear_likes
+	var likes, events int64
+	db.Model(&models.MusicLike{}).Where("music_id = ?", music.ID).Count(&likes)
+	db.Model(&models.PlayEvent{}).Where("music_id = ?", music.ID).Count(&events)
+	if likes != 2 || events != 0 {
+		t.Errorf("likes = %d, events = %d after reset, want 2/0", likes, events)
+	}
+
+	status, body = doRequest(t, app, "POST", fmt.Sprintf("/admin/musics/%d/reset-counts?clear_likes=true", music.ID), nil)
+	if status != 200 {
+		t.Fatalf("clear_likes status = %d, body = %v", status, body)
+	}
+	if got := dataMap(t, body)["deleted_likes"]; got != float64(2) {
+		t.Errorf("deleted_likes = %v, want 2", got)
+	}
+	db.Model(&models.MusicLike{}).Where("music_id = ?", music.ID).Count(&likes)
+	if likes != 0 {
+		t.Errorf("likes after clear_likes = %d, want 0", likes)
+	}
+
+	var untouched models.Music
+	db.First(&untouched, other.ID)
+	db.Model(&models.MusicLike{}).Where("music_id = ?", other.ID).Count(&likes)
+	if *untouched.PlayCount != 3 || likes != 1 {
+		t.Errorf("other music play_count = %d, likes = %d, want 3/1", *untouched.PlayCount, likes)
+	}
+
+	var logs []models.AuditLog
+	db.Where("entity_type = ? AND entity_id = ?", "music", music.ID).Order("id ASC").Find(&logs)
+	if len(logs) != 2 {
+		t.Fatalf("audit logs = %+v, want 2", logs)
+	}
+	if logs[0].Action != "music.reset_counts" || logs[0].UserID != 9 {
+		t.Errorf("audit log = %+v, want music.reset_counts by user 9", logs[0])
+	}
+	if logs[0].Details == nil || !strings.Contains(*logs[0].Details, `"previous_play_count":500`) {
+		t.Errorf("audit details = %v, want previous_play_count 500", logs[0].Details)
+	}
+
+	if status, _ := doRequest(t, app, "POST", "/admin/musics/9999/reset-counts", nil); status != 404 {
+		t.Errorf("missing music status = %d, want 404", status)
+	}
+}
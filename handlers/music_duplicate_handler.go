@@ -0,0 +1,351 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// DuplicateMusicGroup struct untuk satu kelompok music yang kemungkinan duplikat
+type DuplicateMusicGroup struct {
+	Title    string         `json:"title"`
+	ArtistID int            `json:"artist_id"`
+	Duration string         `json:"duration"`
+	Total    int64          `json:"total"`
+	Musics   []models.Music `json:"musics" gorm:"-"`
+}
+
+// GetDuplicateMusicsHandler mendapatkan kelompok music yang kemungkinan duplikat
+// @Summary      Get duplicate musics
+// @Description  Group musics that share the same normalized title, artist and duration (admin only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /admin/musics/duplicates [get]
+func GetDuplicateMusicsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	var groups []DuplicateMusicGroup
+
+	// Kelompokkan berdasarkan judul (case-insensitive, tanpa spasi di ujung), artist dan durasi
+	if err := db.Model(&models.Music{}).
+		Select("LOWER(TRIM(title)) AS title, artist_id, duration, COUNT(*) AS total").
+		Group("LOWER(TRIM(title)), artist_id, duration").
+		Having("COUNT(*) > 1").
+		Order("total DESC").
+		Scan(&groups).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data duplikat music",
+			"error":   err.Error(),
+		})
+	}
+
+	for i := range groups {
+		if err := db.Where("LOWER(TRIM(title)) = ? AND artist_id = ? AND duration = ?", groups[i].Title, groups[i].ArtistID, groups[i].Duration).
+			Order("created_at ASC").
+			Find(&groups[i].Musics).Error; err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil data duplikat music",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    groups,
+		"count":   len(groups),
+	})
+}
+
+// MergeMusicHandler menggabungkan music duplikat ke music lain
+// @Summary      Merge duplicate music
+// @Description  Repoint playlist songs, likes, play events, genres, linked versions and artist pins from a duplicate music to the surviving one, combine the counters and soft delete the duplicate (admin only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Duplicate Music ID"
+// @Param        into_id  path      int  true  "Surviving Music ID"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /admin/musics/{id}/merge/{into_id} [post]
+func MergeMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
+	sourceID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "ID music tidak valid",
+		})
+	}
+	targetID, err := strconv.ParseUint(c.Params("into_id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "ID music tujuan tidak valid",
+		})
+	}
+	if sourceID == targetID {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Music tidak dapat digabungkan ke dirinya sendiri",
+		})
+	}
+
+	// Mulai transaksi
+	tx := db.Begin()
+
+	var source, target models.Music
+	if err := tx.First(&source, sourceID).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Music tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data music",
+			"error":   err.Error(),
+		})
+	}
+	if err := tx.First(&target, targetID).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Music tujuan tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data music",
+			"error":   err.Error(),
+		})
+	}
+
+	// Playlist yang sudah berisi music tujuan tidak boleh mendapat lagu yang sama dua kali
+	var targetPlaylistIDs []uint
+	if err := tx.Model(&models.PlaylistSong{}).Where("music_id = ?", target.ID).Pluck("playlist_id", &targetPlaylistIDs).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlist songs",
+			"error":   err.Error(),
+		})
+	}
+	if len(targetPlaylistIDs) > 0 {
+		if err := tx.Where("music_id = ? AND playlist_id IN ?", source.ID, targetPlaylistIDs).Delete(&models.PlaylistSong{}).Error; err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal menghapus playlist songs duplikat",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	playlistResult := tx.Model(&models.PlaylistSong{}).Where("music_id = ?", source.ID).Update("music_id", target.ID)
+	if playlistResult.Error != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal memindahkan playlist songs",
+			"error":   playlistResult.Error.Error(),
+		})
+	}
+
+	// User yang menyukai kedua music hanya dihitung satu kali
+	var targetLikeUserIDs []uint
+	if err := tx.Model(&models.MusicLike{}).Where("music_id = ?", target.ID).Pluck("user_id", &targetLikeUserIDs).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data likes",
+			"error":   err.Error(),
+		})
+	}
+	var overlappingLikes int64
+	if len(targetLikeUserIDs) > 0 {
+		result := tx.Where("music_id = ? AND user_id IN ?", source.ID, targetLikeUserIDs).Delete(&models.MusicLike{})
+		if result.Error != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal menghapus likes duplikat",
+				"error":   result.Error.Error(),
+			})
+		}
+		overlappingLikes = result.RowsAffected
+	}
+
+	likeResult := tx.Model(&models.MusicLike{}).Where("music_id = ?", source.ID).Update("music_id", target.ID)
+	if likeResult.Error != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal memindahkan likes",
+			"error":   likeResult.Error.Error(),
+		})
+	}
+
+	// Genre yang sudah dimiliki music tujuan tidak boleh dobel
+	var targetGenreIDs []uint
+	if err := tx.Model(&models.MusicGenre{}).Where("music_id = ?", target.ID).Pluck("genre_id", &targetGenreIDs).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data genre music",
+			"error":   err.Error(),
+		})
+	}
+	if len(targetGenreIDs) > 0 {
+		if err := tx.Where("music_id = ? AND genre_id IN ?", source.ID, targetGenreIDs).Delete(&models.MusicGenre{}).Error; err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal menghapus genre music duplikat",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	genreResult := tx.Model(&models.MusicGenre{}).Where("music_id = ?", source.ID).Update("music_id", target.ID)
+	if genreResult.Error != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal memindahkan genre music",
+			"error":   genreResult.Error.Error(),
+		})
+	}
+
+	// Riwayat play ikut dipindahkan agar popularity dan statistik tidak hilang
+	playEventResult := tx.Model(&models.PlayEvent{}).Where("music_id = ?", source.ID).Update("music_id", target.ID)
+	if playEventResult.Error != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal memindahkan play events",
+			"error":   playEventResult.Error.Error(),
+		})
+	}
+
+	// Pin artist pada music duplikat dipindahkan ke music tujuan
+	if err := tx.Model(&models.Artist{}).Where("pinned_music_id = ?", source.ID).Update("pinned_music_id", target.ID).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate pinned music artist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Versi yang menginduk ke music duplikat dipindahkan ke music utama dari music tujuan.
+	// Jika music tujuan sendiri adalah versi dari duplikat, music tujuan menjadi music utama.
+	if target.ParentMusicID != nil && *target.ParentMusicID == source.ID {
+		if err := tx.Model(&models.Music{}).Where("id = ?", target.ID).Update("parent_music_id", nil).Error; err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengupdate versi music",
+				"error":   err.Error(),
+			})
+		}
+		target.ParentMusicID = nil
+	}
+	targetRootID := target.ID
+	if target.ParentMusicID != nil {
+		targetRootID = *target.ParentMusicID
+	}
+	if err := moveMusicVersions(tx, source.ID, &targetRootID); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate versi music",
+			"error":   err.Error(),
+		})
+	}
+
+	// Gabungkan counter
+	playCount := 0
+	likeCount := 0
+	totalStream := 0
+	if target.PlayCount != nil {
+		playCount += *target.PlayCount
+	}
+	if source.PlayCount != nil {
+		playCount += *source.PlayCount
+	}
+	if target.LikeCount != nil {
+		likeCount += *target.LikeCount
+	}
+	if source.LikeCount != nil {
+		likeCount += *source.LikeCount
+	}
+	likeCount -= int(overlappingLikes)
+	if likeCount < 0 {
+		likeCount = 0
+	}
+	if target.TotalStream != nil {
+		totalStream += *target.TotalStream
+	}
+	if source.TotalStream != nil {
+		totalStream += *source.TotalStream
+	}
+	target.PlayCount = &playCount
+	target.LikeCount = &likeCount
+	target.TotalStream = &totalStream
+
+	if err := tx.Save(&target).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate music tujuan",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Delete(&source).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghapus music duplikat",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menggabungkan music",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Music berhasil digabungkan",
+		"data":    target,
+		"merged": fiber.Map{
+			"from_music_id":          source.ID,
+			"playlist_songs_moved":   playlistResult.RowsAffected,
+			"likes_moved":            likeResult.RowsAffected,
+			"duplicate_likes_merged": overlappingLikes,
+			"play_events_moved":      playEventResult.RowsAffected,
+			"genres_moved":           genreResult.RowsAffected,
+		},
+	})
+}
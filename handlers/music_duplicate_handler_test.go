@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"testing"
+)
+
+func TestGetDuplicateMusicsGroupsSameTitleArtistAndDuration(t *testing.T) {
+	db := testdb.New(t)
+
+	mustCreate(t, db, &[]models.Music{
+		testMusic("Hujan", 1),
+		testMusic(" hujan ", 1),
+		testMusic("Hujan", 2),
+		testMusic("Pelangi", 1),
+	})
+
+	app := newTestApp(db, "GET", "/admin/musics/duplicates", 1, "admin", GetDuplicateMusicsHandler)
+	status, body := doRequest(t, app, "GET", "/admin/musics/duplicates", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	groups := dataList(t, body)
+	if len(groups) != 1 {
+		t.Fatalf("groups = %v, want 1 group", groups)
+	}
+	if groups[0]["title"] != "hujan" || groups[0]["total"] != float64(2) {
+		t.Errorf("group = %v, want hujan with 2 musics", groups[0])
+	}
+}
+
+func TestMergeMusicRepointsPlaylistSongsAndCombinesCounts(t *testing.T) {
+	db := testdb.New(t)
+
+	source := testMusic("Duplicate", 1)
+	source.PlayCount, source.LikeCount, source.TotalStream = intPtr(5), intPtr(2), intPtr(7)
+	target := testMusic("Original", 1)
+	target.PlayCount, target.LikeCount, target.TotalStream = intPtr(10), intPtr(1), intPtr(3)
+	mustCreate(t, db, &source)
+	mustCreate(t, db, &target)
+
+	mustCreate(t, db, &[]models.PlaylistSong{
+		{PlaylistID: 1, MusicID: source.ID},
+		{PlaylistID: 2, MusicID: source.ID}, // Playlist 2 juga berisi target, jadi tidak boleh dobel
+		{PlaylistID: 2, MusicID: target.ID},
+	})
+	mustCreate(t, db, &[]models.MusicLike{
+		{UserID: 1, MusicID: source.ID},
+		{UserID: 2, MusicID: source.ID}, // User 2 menyukai keduanya, dihitung sekali
+		{UserID: 2, MusicID: target.ID},
+	})
+	genres := seedGenres(t, db, "Pop", "Rock")
+	mustCreate(t, db, &[]models.MusicGenre{
+		{MusicID: source.ID, GenreID: genres["Pop"]},
+		{MusicID: source.ID, GenreID: genres["Rock"]},
+		{MusicID: target.ID, GenreID: genres["Pop"]}, // Sudah dimiliki target, tidak boleh dobel
+	})
+	mustCreate(t, db, &[]models.PlayEvent{
+		{MusicID: source.ID, UserID: 1},
+		{MusicID: source.ID, UserID: 2},
+		{MusicID: target.ID, UserID: 1},
+	})
+
+	app := newTestApp(db, "POST", "/admin/musics/:id/merge/:into_id", 1, "admin", MergeMusicHandler)
+	status, body := doRequest(t, app, "POST", fmt.Sprintf("/admin/musics/%d/merge/%d", source.ID, target.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	var songs []models.PlaylistSong
+	db.Order("playlist_id").Find(&songs)
+	if len(songs) != 2 {
+		t.Fatalf("playlist songs = %+v, want 2", songs)
+	}
+	for _, song := range songs {
+		if song.MusicID != target.ID {
+			t.Errorf("playlist %d still references music %d", song.PlaylistID, song.MusicID)
+		}
+	}
+
+	var merged models.Music
+	db.First(&merged, target.ID)
+	if *merged.PlayCount != 15 || *merged.LikeCount != 2 || *merged.TotalStream != 10 {
+		t.Errorf("counts = play %d like %d stream %d, want 15/2/10", *merged.PlayCount, *merged.LikeCount, *merged.TotalStream)
+	}
+
+	var likes int64
+	db.Model(&models.MusicLike{}).Where("music_id = ?", target.ID).Count(&likes)
+	if likes != 2 {
+		t.Errorf("likes on target = %d, want 2", likes)
+	}
+
+	if got, want := musicGenreIDs(t, db, target.ID), sortedUints(genres["Pop"], genres["Rock"]); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("genres on target = %v, want %v", got, want)
+	}
+	if got := musicGenreIDs(t, db, source.ID); len(got) != 0 {
+		t.Errorf("genres left on duplicate = %v, want none", got)
+	}
+
+	var events int64
+	db.Model(&models.PlayEvent{}).Where("music_id = ?", target.ID).Count(&events)
+	if events != 3 {
+		t.Errorf("play events on target = %d, want 3", events)
+	}
+
+	if err := db.First(&models.Music{}, source.ID).Error; err == nil {
+		t.Error("duplicate music is still visible after merge")
+	}
+}
+
+func TestMergeMusicRepointsLinkedVersions(t *testing.T) {
+	db := testdb.New(t)
+
+	source := testMusic("Duplicate", 1)
+	mustCreate(t, db, &source)
+	version := testMusic("Duplicate (Clean)", 1)
+	version.ParentMusicID = &source.ID
+	mustCreate(t, db, &version)
+	// Music tujuan sendiri adalah versi dari duplikat
+	target := testMusic("Original", 1)
+	target.ParentMusicID = &source.ID
+	mustCreate(t, db, &target)
+
+	app := newTestApp(db, "POST", "/admin/musics/:id/merge/:into_id", 1, "admin", MergeMusicHandler)
+	status, body := doRequest(t, app, "POST", fmt.Sprintf("/admin/musics/%d/merge/%d", source.ID, target.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	var merged, moved models.Music
+	db.First(&merged, target.ID)
+	db.First(&moved, version.ID)
+	if merged.ParentMusicID != nil {
+		t.Errorf("target parent_music_id = %d, want nil (target becomes the main version)", *merged.ParentMusicID)
+	}
+	if moved.ParentMusicID == nil || *moved.ParentMusicID != target.ID {
+		t.Errorf("version parent_music_id = %v, want %d", moved.ParentMusicID, target.ID)
+	}
+}
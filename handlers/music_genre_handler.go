@@ -0,0 +1,293 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// maxMusicGenreAssignRows batas jumlah baris dalam satu kali assign genre
+const maxMusicGenreAssignRows = 500
+
+// Status hasil assign genre per baris
+const (
+	MusicGenreAssignUpdated  = "updated"
+	MusicGenreAssignNotFound = "not_found"
+	MusicGenreAssignInvalid  = "invalid"
+)
+
+// AssignMusicGenresRequest struct untuk satu baris assign genre
+type AssignMusicGenresRequest struct {
+	MusicID  uint   `json:"music_id" validate:"required"`
+	GenreIDs []uint `json:"genre_ids" validate:"required"` // Genre pertama menjadi genre utama music
+}
+
+// MusicGenreAssignResult struct untuk hasil assign genre satu baris
+type MusicGenreAssignResult struct {
+	Row      int    `json:"row"` // Dimulai dari 1 sesuai urutan array request
+	Status   string `json:"status"`
+	Message  string `json:"message,omitempty"`
+	MusicID  uint   `json:"music_id"`
+	GenreIDs []uint `json:"genre_ids"`
+}
+
+// AssignMusicGenresHandler mengganti genre banyak music sekaligus (admin)
+// @Summary      Bulk assign music genres
+// @Description  Replace the genres of many musics at once (admin only). For each valid row the music_genres rows of the music are replaced and the first genre becomes the music's primary genre. All changes run in one transaction. Each row reports updated, not_found or invalid
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        request  body      []AssignMusicGenresRequest  true  "Genres per music"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /admin/musics/genres [post]
+func AssignMusicGenresHandler(c *fiber.Ctx, db *gorm.DB) error {
+	var rows []AssignMusicGenresRequest
+	if err := c.BodyParser(&rows); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal parse request body",
+			"error":   err.Error(),
+		})
+	}
+	if len(rows) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Data genre music tidak boleh kosong",
+		})
+	}
+	if len(rows) > maxMusicGenreAssignRows {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Maksimal 500 music dalam satu kali assign genre",
+		})
+	}
+
+	// Kumpulkan music dan genre untuk dicek ke database dalam satu query masing-masing
+	musicIDs := make([]uint, 0, len(rows))
+	genreIDs := make([]uint, 0)
+	for i := range rows {
+		rows[i].GenreIDs = uniqueUints(rows[i].GenreIDs)
+		musicIDs = append(musicIDs, rows[i].MusicID)
+		genreIDs = append(genreIDs, rows[i].GenreIDs...)
+	}
+
+	var existingMusicIDs []uint
+	if err := db.Model(&models.Music{}).Where("id IN ?", musicIDs).Pluck("id", &existingMusicIDs).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data music",
+			"error":   err.Error(),
+		})
+	}
+	musicExists := make(map[uint]bool, len(existingMusicIDs))
+	for _, id := range existingMusicIDs {
+		musicExists[id] = true
+	}
+
+	var genres []models.Genre
+	if len(genreIDs) > 0 {
+		if err := db.Select("id", "name").Where("id IN ?", uniqueUints(genreIDs)).Find(&genres).Error; err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil data genre",
+				"error":   err.Error(),
+			})
+		}
+	}
+	genreNames := make(map[uint]string, len(genres))
+	for _, genre := range genres {
+		genreNames[genre.ID] = genre.Name
+	}
+
+	results := make([]MusicGenreAssignResult, len(rows))
+	seenMusics := make(map[uint]bool)
+	var toUpdate []int
+	for i, req := range rows {
+		results[i] = MusicGenreAssignResult{
+			Row:      i + 1,
+			MusicID:  req.MusicID,
+			GenreIDs: req.GenreIDs,
+		}
+
+		if message := validateMusicGenreAssignRow(req, genreNames); message != "" {
+			results[i].Status = MusicGenreAssignInvalid
+			results[i].Message = message
+			continue
+		}
+		if seenMusics[req.MusicID] {
+			results[i].Status = MusicGenreAssignInvalid
+			results[i].Message = "music_id duplikat dalam request"
+			continue
+		}
+		if !musicExists[req.MusicID] {
+			results[i].Status = MusicGenreAssignNotFound
+			results[i].Message = "Music tidak ditemukan"
+			continue
+		}
+
+		seenMusics[req.MusicID] = true
+		toUpdate = append(toUpdate, i)
+	}
+
+	// Mulai transaksi
+	tx := db.Begin()
+
+	for _, i := range toUpdate {
+		req := rows[i]
+
+		if err := tx.Where("music_id = ?", req.MusicID).Delete(&models.MusicGenre{}).Error; err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal menghapus genre music",
+				"error":   err.Error(),
+				"row":     i + 1,
+			})
+		}
+
+		musicGenres := make([]models.MusicGenre, 0, len(req.GenreIDs))
+		for _, genreID := range req.GenreIDs {
+			musicGenres = append(musicGenres, models.MusicGenre{MusicID: req.MusicID, GenreID: genreID})
+		}
+		if err := tx.Create(&musicGenres).Error; err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal menyimpan genre music",
+				"error":   err.Error(),
+				"row":     i + 1,
+			})
+		}
+
+		// Genre pertama disimpan di kolom genre music agar filter yang ada tetap konsisten
+		if err := tx.Model(&models.Music{}).Where("id = ?", req.MusicID).Update("genre", genreNames[req.GenreIDs[0]]).Error; err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengupdate genre music",
+				"error":   err.Error(),
+				"row":     i + 1,
+			})
+		}
+
+		results[i].Status = MusicGenreAssignUpdated
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate genre music",
+			"error":   err.Error(),
+		})
+	}
+
+	summary := map[string]int{
+		MusicGenreAssignUpdated:  0,
+		MusicGenreAssignNotFound: 0,
+		MusicGenreAssignInvalid:  0,
+	}
+	for _, result := range results {
+		summary[result.Status]++
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Assign genre music selesai",
+		"data":    results,
+		"summary": summary,
+	})
+}
+
+// findMusicGenres mengambil semua genre music dari music_genres sesuai urutan assign (genre utama lebih dulu)
+func findMusicGenres(db *gorm.DB, musicID uint) ([]models.Genre, error) {
+	genres := make([]models.Genre, 0)
+	err := db.Joins("JOIN music_genres ON music_genres.genre_id = genres.id").
+		Where("music_genres.music_id = ?", musicID).
+		Order("music_genres.id ASC").
+		Find(&genres).Error
+	return genres, err
+}
+
+// syncPrimaryMusicGenre menyamakan music_genres dengan kolom genre music setelah genre diganti lewat create/update.
+// Genre lama dilepas, genre baru (jika ada di katalog genre) menjadi baris pertama, genre tambahan lain dipertahankan.
+func syncPrimaryMusicGenre(tx *gorm.DB, musicID uint, oldGenre, newGenre string) error {
+	oldGenre = strings.ToLower(strings.TrimSpace(oldGenre))
+	newGenre = strings.ToLower(strings.TrimSpace(newGenre))
+
+	var currentIDs []uint
+	if err := tx.Model(&models.MusicGenre{}).Where("music_id = ?", musicID).Order("id ASC").Pluck("genre_id", &currentIDs).Error; err != nil {
+		return err
+	}
+
+	var genres []models.Genre
+	if err := tx.Select("id", "name").Where("LOWER(name) IN ?", []string{oldGenre, newGenre}).Find(&genres).Error; err != nil {
+		return err
+	}
+	oldIDs := make(map[uint]bool)
+	genreIDs := make([]uint, 0, len(currentIDs)+1)
+	for _, genre := range genres {
+		switch strings.ToLower(genre.Name) {
+		case newGenre:
+			genreIDs = append(genreIDs, genre.ID)
+		case oldGenre:
+			oldIDs[genre.ID] = true
+		}
+	}
+	for _, id := range currentIDs {
+		if !oldIDs[id] {
+			genreIDs = append(genreIDs, id)
+		}
+	}
+	genreIDs = uniqueUints(genreIDs)
+
+	// Baris dibuat ulang agar urutan id mengikuti urutan genre (genre utama lebih dulu)
+	if err := tx.Where("music_id = ?", musicID).Delete(&models.MusicGenre{}).Error; err != nil {
+		return err
+	}
+	if len(genreIDs) == 0 {
+		return nil
+	}
+	musicGenres := make([]models.MusicGenre, 0, len(genreIDs))
+	for _, genreID := range genreIDs {
+		musicGenres = append(musicGenres, models.MusicGenre{MusicID: musicID, GenreID: genreID})
+	}
+	return tx.Create(&musicGenres).Error
+}
+
+// validateMusicGenreAssignRow mengembalikan pesan error jika baris assign genre tidak valid
+func validateMusicGenreAssignRow(req AssignMusicGenresRequest, genreNames map[uint]string) string {
+	if req.MusicID == 0 {
+		return "music_id wajib diisi"
+	}
+	if len(req.GenreIDs) == 0 {
+		return "genre_ids wajib diisi"
+	}
+	for _, genreID := range req.GenreIDs {
+		if _, ok := genreNames[genreID]; !ok {
+			return fmt.Sprintf("genre_id %d tidak ditemukan", genreID)
+		}
+	}
+	return ""
+}
+
+// uniqueUints menghapus id duplikat dengan tetap mempertahankan urutan
+func uniqueUints(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// seedGenres membuat genre dengan nama yang diberikan dan mengembalikan id per nama
+func seedGenres(t *testing.T, db *gorm.DB, names ...string) map[string]uint {
+	t.Helper()
+
+	ids := make(map[string]uint, len(names))
+	for _, name := range names {
+		genre := models.Genre{Name: name, Description: name}
+		mustCreate(t, db, &genre)
+		ids[name] = genre.ID
+	}
+	return ids
+}
+
+// musicGenreIDs mengambil genre_id milik music dari music_genres, diurutkan
+func musicGenreIDs(t *testing.T, db *gorm.DB, musicID uint) []uint {
+	t.Helper()
+
+	var ids []uint
+	if err := db.Model(&models.MusicGenre{}).Where("music_id = ?", musicID).Order("genre_id").Pluck("genre_id", &ids).Error; err != nil {
+		t.Fatal(err)
+	}
+	return ids
+}
+
+func sortedUints(ids ...uint) []uint {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestAssignMusicGenresHandlerReplacesJoinRowsPerTrack(t *testing.T) {
+	db := testdb.New(t)
+	genres := seedGenres(t, db, "Pop", "Rock", "Jazz")
+
+	first := testMusic("First", 1)
+	second := testMusic("Second", 1)
+	mustCreate(t, db, &first)
+	mustCreate(t, db, &second)
+	mustCreate(t, db, &[]models.MusicGenre{
+		{MusicID: first.ID, GenreID: genres["Pop"]},
+		{MusicID: second.ID, GenreID: genres["Rock"]},
+	})
+
+	app := newTestApp(db, "POST", "/admin/musics/genres", 1, "admin", AssignMusicGenresHandler)
+	status, body := doRequest(t, app, "POST", "/admin/musics/genres", []map[string]interface{}{
+		{"music_id": first.ID, "genre_ids": []uint{genres["Rock"], genres["Jazz"]}},
+		{"music_id": second.ID, "genre_ids": []uint{genres["Jazz"]}},
+		{"music_id": 9999, "genre_ids": []uint{genres["Pop"]}},
+		{"music_id": first.ID, "genre_ids": []uint{genres["Pop"]}},
+		{"music_id": second.ID, "genre_ids": []uint{777}},
+	})
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	want := []string{
+		MusicGenreAssignUpdated,
+		MusicGenreAssignUpdated,
+		MusicGenreAssignNotFound,
+		MusicGenreAssignInvalid,
+		MusicGenreAssignInvalid,
+	}
+	for i, result := range dataList(t, body) {
+		if result["status"] != want[i] {
+			t.Errorf("row %d = %v, want status %s", i+1, result, want[i])
+		}
+	}
+
+	if got, want := musicGenreIDs(t, db, first.ID), sortedUints(genres["Rock"], genres["Jazz"]); !reflect.DeepEqual(got, want) {
+		t.Errorf("first genres = %v, want %v", got, want)
+	}
+	if got, want := musicGenreIDs(t, db, second.ID), []uint{genres["Jazz"]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("second genres = %v, want %v", got, want)
+	}
+
+	var updated models.Music
+	db.First(&updated, first.ID)
+	if updated.Genre != "Rock" {
+		t.Errorf("primary genre = %q, want Rock", updated.Genre)
+	}
+}
+
+func TestMusicGenresAreReadableAndCleanedUp(t *testing.T) {
+	db := testdb.New(t)
+	genres := seedGenres(t, db, "Pop", "Rock", "Jazz")
+
+	first := testMusic("First", 1)
+	second := testMusic("Second", 1)
+	mustCreate(t, db, &first)
+	mustCreate(t, db, &second)
+	mustCreate(t, db, &[]models.MusicGenre{
+		{MusicID: first.ID, GenreID: genres["Rock"]},
+		{MusicID: first.ID, GenreID: genres["Jazz"]},
+		{MusicID: second.ID, GenreID: genres["Jazz"]},
+	})
+
+	// Detail music memuat semua genre sesuai urutan assign
+	detail := newTestApp(db, "GET", "/musics/:id", 1, "user", GetMusicHandler)
+	status, body := doRequest(t, detail, "GET", fmt.Sprintf("/musics/%d", first.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	var names []string
+	for _, genre := range dataMap(t, body)["genres"].([]interface{}) {
+		names = append(names, genre.(map[string]interface{})["name"].(string))
+	}
+	if want := []string{"Rock", "Jazz"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("detail genres = %v, want %v", names, want)
+	}
+
+	// Filter genre_id mencocokkan semua genre music, bukan hanya genre utama
+	list := newTestApp(db, "GET", "/musics", 1, "user", GetMusicsHandler)
+	status, body = doRequest(t, list, "GET", fmt.Sprintf("/musics?genre_id=%d&sort_by=id&order=asc", genres["Jazz"]), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got, want := musicTitles(t, body), []string{"First", "Second"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("genre_id Jazz titles = %v, want %v", got, want)
+	}
+	status, body = doRequest(t, list, "GET", fmt.Sprintf("/musics?genre_id=%d", genres["Pop"]), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got := musicTitles(t, body); len(got) != 0 {
+		t.Errorf("genre_id Pop titles = %v, want none", got)
+	}
+
+	// Menghapus music ikut menghapus relasi genre
+	remove := newTestApp(db, "DELETE", "/musics/:id", 1, "admin", DeleteMusicHandler)
+	if status, body := doRequest(t, remove, "DELETE", fmt.Sprintf("/musics/%d", first.ID), nil); status != 200 {
+		t.Fatalf("delete status = %d, body = %v", status, body)
+	}
+	if got := musicGenreIDs(t, db, first.ID); len(got) != 0 {
+		t.Errorf("genres after delete = %v, want none", got)
+	}
+	if got := musicGenreIDs(t, db, second.ID); len(got) != 1 {
+		t.Errorf("other music genres = %v, want untouched", got)
+	}
+}
+
+func TestCreateAndUpdateMusicKeepGenresInSync(t *testing.T) {
+	db := testdb.New(t)
+	genres := seedGenres(t, db, "Pop", "Rock", "Jazz")
+	artist := seedArtist(t, db, "Artist", 0)
+
+	create := newTestApp(db, "POST", "/musics", 1, "admin", CreateMusicHandler)
+	status, body := doRequest(t, create, "POST", "/musics", map[string]interface{}{
+		"title":          "Song",
+		"artist":         artist.Name,
+		"artist_id":      artist.ID,
+		"genre":          "rock",
+		"release_date":   "2026-01-02",
+		"duration":       "03:00",
+		"language":       "Indonesian",
+		"audio_file_url": "https://example.com/song.mp3",
+	})
+	if status != 201 {
+		t.Fatalf("create status = %d, body = %v", status, body)
+	}
+	musicID := uint(dataMap(t, body)["id"].(float64))
+	if got, want := musicGenreIDs(t, db, musicID), []uint{genres["Rock"]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("genres after create = %v, want %v", got, want)
+	}
+
+	// Genre tambahan dari bulk assign tetap ada saat genre utama diganti
+	mustCreate(t, db, &models.MusicGenre{MusicID: musicID, GenreID: genres["Pop"]})
+
+	update := newTestApp(db, "PUT", "/musics/:id", 1, "admin", UpdateMusicHandler)
+	status, body = doRequest(t, update, "PUT", fmt.Sprintf("/musics/%d", musicID), map[string]interface{}{"genre": "Jazz"})
+	if status != 200 {
+		t.Fatalf("update status = %d, body = %v", status, body)
+	}
+
+	var rows []models.MusicGenre
+	db.Where("music_id = ?", musicID).Order("id ASC").Find(&rows)
+	var got []uint
+	for _, row := range rows {
+		got = append(got, row.GenreID)
+	}
+	if want := []uint{genres["Jazz"], genres["Pop"]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("genres after update = %v, want %v (new primary first)", got, want)
+	}
+
+	list := newTestApp(db, "GET", "/musics", 1, "user", GetMusicsHandler)
+	for name, want := range map[string]int{"Jazz": 1, "Pop": 1, "Rock": 0} {
+		status, body := doRequest(t, list, "GET", fmt.Sprintf("/musics?genre_id=%d", genres[name]), nil)
+		if status != 200 {
+			t.Fatalf("status = %d, body = %v", status, body)
+		}
+		if got := len(musicTitles(t, body)); got != want {
+			t.Errorf("genre_id %s matched %d musics, want %d", name, got, want)
+		}
+	}
+}
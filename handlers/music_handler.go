@@ -2,11 +2,14 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
+	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CreateMusicRequest struct untuk request create music
@@ -31,6 +34,8 @@ type CreateMusicRequest struct {
 	SubmittedBy   string  `json:"submitted_by"`
 	IsTop100      *int    `json:"is_top100"`
 	Notes         *string `json:"notes"`
+	ParentMusicID *uint   `json:"parent_music_id"`
+	VersionLabel  *string `json:"version_label"`
 }
 
 // UpdateMusicRequest struct untuk request update music
@@ -58,6 +63,18 @@ type UpdateMusicRequest struct {
 	ApprovedBy    *int    `json:"approved_by"`
 	TotalStream   *int    `json:"total_stream"`
 	Notes         *string `json:"notes"`
+	ParentMusicID *uint   `json:"parent_music_id"` // 0 untuk melepas hubungan versi
+	VersionLabel  *string `json:"version_label"`
+}
+
+// MusicDetail struct untuk response detail music beserta relasi yang di-expand
+type MusicDetail struct {
+	models.Music
+	ArtistDetail *models.Artist `json:"artist_detail,omitempty"`
+	AlbumDetail  *models.Album  `json:"album_detail,omitempty"`
+	GenreDetail  *models.Genre  `json:"genre_detail,omitempty"`
+	Genres       []models.Genre `json:"genres"`     // Semua genre dari music_genres
+	Popularity   float64        `json:"popularity"` // Popularity score 0-100, lihat music_popularity_handler.go
 }
 
 // CreateMusicHandler membuat music baru
@@ -130,6 +147,36 @@ func CreateMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		submittedBy = req.SubmittedBy
 	}
 
+	// Hubungkan ke versi lain dari lagu yang sama
+	var parentMusicID *uint
+	if req.ParentMusicID != nil && *req.ParentMusicID > 0 {
+		rootID, err := findMusicVersionRoot(db, *req.ParentMusicID)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"success": false,
+					"message": "Parent music tidak ditemukan",
+				})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil data parent music",
+				"error":   err.Error(),
+			})
+		}
+		parentMusicID = &rootID
+	}
+
+	// Ukuran file diambil dari catatan upload, bukan dari client
+	audioFileSize, err := uploadedFileSize(db, req.AudioFileURL)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil ukuran file audio",
+			"error":   err.Error(),
+		})
+	}
+
 	// Buat music baru
 	music := models.Music{
 		Title:         req.Title,
@@ -146,6 +193,7 @@ func CreateMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		Description:   req.Description,
 		Tags:          req.Tags,
 		AudioFileURL:  req.AudioFileURL,
+		AudioFileSize: audioFileSize,
 		CoverImageURL: req.CoverImageURL,
 		PlayCount:     &playCount,
 		LikeCount:     &likeCount,
@@ -153,9 +201,33 @@ func CreateMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		IsApproved:    &isApproved,
 		IsTop100:      &isTop100,
 		Notes:         req.Notes,
+		ParentMusicID: parentMusicID,
+		VersionLabel:  req.VersionLabel,
+	}
+
+	// Mulai transaksi
+	tx := db.Begin()
+
+	if err := tx.Create(&music).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal membuat music",
+			"error":   err.Error(),
+		})
+	}
+
+	// Genre music juga dicatat di music_genres
+	if err := syncPrimaryMusicGenre(tx, music.ID, "", music.Genre); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menyimpan genre music",
+			"error":   err.Error(),
+		})
 	}
 
-	if err := db.Create(&music).Error; err != nil {
+	if err := tx.Commit().Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal membuat music",
@@ -177,37 +249,56 @@ func CreateMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Accept       json
 // @Produce      json
 // @Param        page        query     int     false  "Page number" default(1)
-// @Param        limit       query     int     false  "Items per page" default(10)
+// @Param        limit       query     int     false  "Items per page (max PAGINATION_MAX_LIMIT)" default(10)
+// @Param        skip_total  query     bool    false  "Skip total count and return has_more only" default(false)
 // @Param        artist      query     string  false  "Filter by artist"
 // @Param        genre       query     string  false  "Filter by genre"
+// @Param        genre_id    query     int     false  "Filter by genre ID (any of the music's assigned genres)"
 // @Param        album_id    query     int     false  "Filter by album ID"
-// @Param        search      query     string  false  "Search by title, artist, or album"
+// @Param        search      query     string  false  "Search by title, artist, or album. Search results are trimmed and omit lyrics"
+// @Param        expand      query     string  false  "Include omitted fields in search results (lyrics)"
+// @Param        sort        query     string  false  "Use editorial to rank by editorial weight of the music and its genre, then play count"
 // @Param        sort_by     query     string  false  "Sort field" default(created_at)
 // @Param        order       query     string  false  "Sort order" default(desc)
 // @Param        is_approved query     int     false  "Filter by approval status (0 or 1)"
 // @Param        is_top100   query     int     false  "Filter by top 100 status (0 or 1)"
 // @Param        submitted_by query    string  false  "Filter by submitted_by (artist, label, admin)"
+// @Param        safe        query     bool    false  "Swap explicit tracks to their clean version when available"
 // @Success      200         {object}  map[string]interface{}
+// @Failure      400         {object}  map[string]interface{}
 // @Failure      401         {object}  map[string]interface{}
 // @Failure      500         {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /musics [get]
 func GetMusicsHandler(c *fiber.Ctx, db *gorm.DB) error {
-	var musics []models.Music
-
-	// Pagination
-	page := c.QueryInt("page", 1)
-	limit := c.QueryInt("limit", 10)
-	offset := (page - 1) * limit
-
 	// Query dengan pagination
 	query := db.Model(&models.Music{})
 
-	// Filter by artist_id jika ada
+	// Filter by artist_id jika ada, music yang disematkan artist tampil paling atas
 	if artistID := c.QueryInt("artist_id", 0); artistID > 0 {
 		query = query.Where("artist_id = ?", artistID)
+
+		var artist models.Artist
+		if err := db.Select("id", "pinned_music_id").First(&artist, artistID).Error; err == nil && artist.PinnedMusicID != nil {
+			query = query.Order(musicFirstOrder(*artist.PinnedMusicID))
+		}
+	}
+
+	return respondMusicList(c, db, applyMusicFilters(c, query))
+}
+
+// musicFirstOrder mengurutkan music dengan id tertentu paling atas sebelum urutan lainnya.
+// Dibuat sebagai kolom ORDER BY (bukan gorm.Expr) karena Order() mengabaikan clause.Expr
+// dan clause.OrderBy{Expression} tertimpa oleh Order() berikutnya.
+func musicFirstOrder(musicID uint) clause.OrderByColumn {
+	return clause.OrderByColumn{
+		Column: clause.Column{Name: fmt.Sprintf("musics.id = %d", musicID), Raw: true},
+		Desc:   true,
 	}
+}
 
+// applyMusicFilters menerapkan filter standar list music (album, genre, language, explicit, status, search)
+func applyMusicFilters(c *fiber.Ctx, query *gorm.DB) *gorm.DB {
 	// Filter by album_id jika ada
 	if albumID := c.QueryInt("album_id", 0); albumID > 0 {
 		query = query.Where("album_id = ?", albumID)
@@ -218,6 +309,11 @@ func GetMusicsHandler(c *fiber.Ctx, db *gorm.DB) error {
 		query = query.Where("genre LIKE ?", "%"+genre+"%")
 	}
 
+	// Filter by genre_id lewat music_genres
+	if genreID := c.QueryInt("genre_id", 0); genreID > 0 {
+		query = query.Where("musics.id IN (SELECT music_id FROM music_genres WHERE genre_id = ?)", genreID)
+	}
+
 	// Filter by language jika ada
 	if language := c.Query("language"); language != "" {
 		query = query.Where("language = ?", language)
@@ -251,20 +347,47 @@ func GetMusicsHandler(c *fiber.Ctx, db *gorm.DB) error {
 		query = query.Where("title LIKE ? OR artist LIKE ? OR album LIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
 	}
 
+	return query
+}
+
+// respondMusicList mengurutkan, mempaginasi dan mengirim list music (safe mode dan hasil search diringkas)
+func respondMusicList(c *fiber.Ctx, db *gorm.DB, query *gorm.DB) error {
+	var musics []models.Music
+
+	expand, err := utils.ParseExpand(c.Query("expand"), "lyrics")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
+	}
+
+	// Sort editorial: bobot music + bobot genre lebih dulu, lalu play count
+	switch c.Query("sort") {
+	case "":
+	case "editorial":
+		query = query.Order(editorialScoreOrder).Order("play_count DESC")
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "sort harus editorial",
+		})
+	}
+
 	// Sort by created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
-	// Get total count
-	var total int64
-	query.Count(&total)
-
 	// Get musics
-	if err := query.Offset(offset).Limit(limit).Find(&musics).Error; err != nil {
+	pagination, err := paginateQuery(c, query, &musics)
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal mengambil data musics",
@@ -272,34 +395,62 @@ func GetMusicsHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// Safe mode: tampilkan versi clean jika tersedia
+	if c.QueryBool("safe", false) {
+		musics, err = applySafeVersions(db, query, musics)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil versi clean musics",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	// Hasil search diringkas, lyrics hanya dikirim jika diminta
+	if c.Query("search") != "" {
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{
+			"success":    true,
+			"data":       toMusicSearchResults(musics, expand["lyrics"]),
+			"pagination": pagination,
+		})
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"success": true,
-		"data":    musics,
-		"pagination": fiber.Map{
-			"page":  page,
-			"limit": limit,
-			"total": total,
-			"pages": (int(total) + limit - 1) / limit,
-		},
+		"success":    true,
+		"data":       musics,
+		"pagination": pagination,
 	})
 }
 
 // GetMusicHandler mendapatkan music by ID
 // @Summary      Get music by ID
-// @Description  Get music details by ID
+// @Description  Get music details by ID including its 0-100 popularity score and assigned genres. Use expand to embed related artist, album and genre objects
 // @Tags         Musics
 // @Accept       json
 // @Produce      json
-// @Param        id   path      int  true  "Music ID"
-// @Success      200  {object}  map[string]interface{}
-// @Failure      401  {object}  map[string]interface{}
-// @Failure      404  {object}  map[string]interface{}
-// @Failure      500  {object}  map[string]interface{}
+// @Param        id                         path      int     true   "Music ID"
+// @Param        expand                     query     string  false  "Comma separated relations to embed (artist, album, genre)"
+// @Param        safe                       query     bool    false  "Return the clean version when the track is explicit and one is available"
+// @Param        include_deleted_relations  query     bool    false  "Also embed soft deleted relations (admin only)" default(false)
+// @Success      200                        {object}  map[string]interface{}
+// @Failure      400                        {object}  map[string]interface{}
+// @Failure      401                        {object}  map[string]interface{}
+// @Failure      404                        {object}  map[string]interface{}
+// @Failure      500                        {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /musics/{id} [get]
 func GetMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 	id := c.Params("id")
 
+	expand, err := utils.ParseExpand(c.Query("expand"), "artist", "album", "genre")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
+	}
+
 	var music models.Music
 	if err := db.First(&music, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -315,9 +466,85 @@ func GetMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// Safe mode: tampilkan versi clean jika tersedia
+	if c.QueryBool("safe", false) {
+		musics, err := applySafeVersions(db, nil, []models.Music{music})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil versi clean music",
+				"error":   err.Error(),
+			})
+		}
+		music = musics[0]
+	}
+
+	popularity, err := musicPopularity(db, music.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghitung popularity music",
+			"error":   err.Error(),
+		})
+	}
+
+	genres, err := findMusicGenres(db, music.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data genre music",
+			"error":   err.Error(),
+		})
+	}
+
+	detail := MusicDetail{Music: music, Genres: genres, Popularity: popularity}
+
+	// Relasi yang tidak ditemukan dibiarkan kosong, hanya error database yang dikembalikan.
+	// Admin dapat menyertakan relasi yang sudah di-soft delete.
+	relations := relationScope(c, db)
+	if expand["artist"] {
+		var artist models.Artist
+		if err := relations.First(&artist, music.ArtistID).Error; err == nil {
+			detail.ArtistDetail = &artist
+		} else if err != gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil data artist",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	if expand["album"] && music.AlbumID != nil {
+		var album models.Album
+		if err := relations.First(&album, *music.AlbumID).Error; err == nil {
+			detail.AlbumDetail = &album
+		} else if err != gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil data album",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	// Genre pada music disimpan sebagai nama
+	if expand["genre"] && music.Genre != "" {
+		var genre models.Genre
+		if err := relations.Where("name = ?", music.Genre).First(&genre).Error; err == nil {
+			detail.GenreDetail = &genre
+		} else if err != gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil data genre",
+				"error":   err.Error(),
+			})
+		}
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
-		"data":    music,
+		"data":    detail,
 	})
 }
 
@@ -384,6 +611,7 @@ func UpdateMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		music.AlbumID = req.AlbumID
 	}
 
+	oldGenre := music.Genre
 	if req.Genre != nil {
 		music.Genre = *req.Genre
 	}
@@ -426,6 +654,15 @@ func UpdateMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	if req.AudioFileURL != nil {
 		music.AudioFileURL = *req.AudioFileURL
+		audioFileSize, err := uploadedFileSize(db, *req.AudioFileURL)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil ukuran file audio",
+				"error":   err.Error(),
+			})
+		}
+		music.AudioFileSize = audioFileSize
 	}
 
 	if req.CoverImageURL != nil {
@@ -470,7 +707,77 @@ func UpdateMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		music.Notes = req.Notes
 	}
 
-	if err := db.Save(&music).Error; err != nil {
+	if req.VersionLabel != nil {
+		music.VersionLabel = req.VersionLabel
+	}
+
+	repointVersions := false
+	if req.ParentMusicID != nil {
+		if *req.ParentMusicID == 0 {
+			music.ParentMusicID = nil
+		} else {
+			rootID, err := findMusicVersionRoot(db, *req.ParentMusicID)
+			if err != nil {
+				if err == gorm.ErrRecordNotFound {
+					return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+						"success": false,
+						"message": "Parent music tidak ditemukan",
+					})
+				}
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"success": false,
+					"message": "Gagal mengambil data parent music",
+					"error":   err.Error(),
+				})
+			}
+			if rootID == music.ID {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"success": false,
+					"message": "Music tidak dapat menjadi versi dari dirinya sendiri",
+				})
+			}
+
+			music.ParentMusicID = &rootID
+			repointVersions = true
+		}
+	}
+
+	tx := db.Begin()
+
+	// Versi lain yang sebelumnya menginduk ke music ini ikut dipindahkan ke music utama yang baru
+	if repointVersions {
+		if err := tx.Model(&models.Music{}).Where("parent_music_id = ?", music.ID).Update("parent_music_id", *music.ParentMusicID).Error; err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengupdate versi music",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	if err := tx.Save(&music).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate music",
+			"error":   err.Error(),
+		})
+	}
+
+	// Genre utama di music_genres mengikuti perubahan kolom genre
+	if music.Genre != oldGenre {
+		if err := syncPrimaryMusicGenre(tx, music.ID, oldGenre, music.Genre); err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengupdate genre music",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal mengupdate music",
@@ -487,7 +794,7 @@ func UpdateMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 // DeleteMusicHandler menghapus music (soft delete)
 // @Summary      Delete music
-// @Description  Soft delete a music by ID
+// @Description  Soft delete a music by ID. Linked versions of a deleted main version are moved under the oldest remaining version
 // @Tags         Musics
 // @Accept       json
 // @Produce      json
@@ -516,7 +823,49 @@ func DeleteMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	if err := db.Delete(&music).Error; err != nil {
+	// Mulai transaksi
+	tx := db.Begin()
+
+	// Relasi genre music ikut dihapus
+	if err := tx.Where("music_id = ?", music.ID).Delete(&models.MusicGenre{}).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghapus genre music",
+			"error":   err.Error(),
+		})
+	}
+
+	// Artist yang mem-pin music ini tidak lagi punya pinned music
+	if err := tx.Model(&models.Artist{}).Where("pinned_music_id = ?", music.ID).Update("pinned_music_id", nil).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate pinned music artist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Versi lain dari music ini tidak boleh menginduk ke music yang sudah dihapus
+	if err := moveMusicVersions(tx, music.ID, music.ParentMusicID); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate versi music",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Delete(&music).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghapus music",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal menghapus music",
@@ -577,6 +926,8 @@ func IncrementPlayCountHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	recordPlayEvent(c, db, music.ID)
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
 		"message": "Play count berhasil diupdate",
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"testing"
+)
+
+func TestGetMusicHandlerExpandsRelations(t *testing.T) {
+	db := testdb.New(t)
+	artist := seedArtist(t, db, "Singer", 0)
+	album := models.Album{Title: "Debut", ArtistID: int(artist.ID), Artist: artist.Name, AlbumType: models.AlbumTypeAlbum}
+	mustCreate(t, db, &album)
+	mustCreate(t, db, &models.Genre{Name: "Pop", Description: "Pop"})
+
+	music := testMusic("Hit", int(artist.ID))
+	albumID := int(album.ID)
+	music.AlbumID = &albumID
+	mustCreate(t, db, &music)
+
+	app := newTestApp(db, "GET", "/musics/:id", 1, "user", GetMusicHandler)
+	path := fmt.Sprintf("/musics/%d", music.ID)
+
+	status, body := doRequest(t, app, "GET", path+"?expand=artist", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	detail := dataMap(t, body)
+	artistDetail, _ := detail["artist_detail"].(map[string]interface{})
+	if artistDetail == nil || artistDetail["name"] != "Singer" {
+		t.Errorf("artist_detail = %v, want artist Singer", detail["artist_detail"])
+	}
+	if _, ok := detail["album_detail"]; ok {
+		t.Errorf("album_detail embedded without being requested: %v", detail["album_detail"])
+	}
+
+	status, body = doRequest(t, app, "GET", path+"?expand=artist,album,genre", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	detail = dataMap(t, body)
+	for _, key := range []string{"artist_detail", "album_detail", "genre_detail"} {
+		if _, ok := detail[key].(map[string]interface{}); !ok {
+			t.Errorf("%s = %v, want embedded object", key, detail[key])
+		}
+	}
+
+	if status, _ := doRequest(t, app, "GET", path+"?expand=lyrics", nil); status != 400 {
+		t.Errorf("unknown expand status = %d, want 400", status)
+	}
+}
+
+func TestDeletedRelationsResolveForAdminOnly(t *testing.T) {
+	db := testdb.New(t)
+	artist := seedArtist(t, db, "Retired", 0)
+	album := models.Album{Title: "Archive", ArtistID: int(artist.ID), Artist: artist.Name, AlbumType: models.AlbumTypeAlbum}
+	mustCreate(t, db, &album)
+	mustCreate(t, db, &models.Genre{Name: "Pop", Description: "Pop"})
+
+	music := testMusic("Old Hit", int(artist.ID))
+	albumID := int(album.ID)
+	music.AlbumID = &albumID
+	mustCreate(t, db, &music)
+
+	if err := db.Delete(&artist).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Delete(&album).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	musicPath := fmt.Sprintf("/musics/%d?expand=artist,album,genre&include_deleted_relations=true", music.ID)
+	albumPath := fmt.Sprintf("/albums/%d?include_deleted_relations=true", album.ID)
+
+	// Admin mendapatkan semua relasi, termasuk yang sudah dihapus, dalam satu request
+	admin := newTestApp(db, "GET", "/musics/:id", 1, "admin", GetMusicHandler)
+	status, body := doRequest(t, admin, "GET", musicPath, nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	detail := dataMap(t, body)
+	for _, key := range []string{"artist_detail", "album_detail", "genre_detail"} {
+		if _, ok := detail[key].(map[string]interface{}); !ok {
+			t.Errorf("admin %s = %v, want embedded object", key, detail[key])
+		}
+	}
+
+	// User biasa tidak bisa melihat relasi yang sudah dihapus
+	user := newTestApp(db, "GET", "/musics/:id", 2, "user", GetMusicHandler)
+	status, body = doRequest(t, user, "GET", musicPath, nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	detail = dataMap(t, body)
+	if _, ok := detail["artist_detail"]; ok {
+		t.Errorf("user sees deleted artist: %v", detail["artist_detail"])
+	}
+	if _, ok := detail["genre_detail"].(map[string]interface{}); !ok {
+		t.Errorf("user genre_detail = %v, want embedded object", detail["genre_detail"])
+	}
+
+	// Artist album yang sudah dihapus juga hanya tampil untuk admin
+	if err := db.Model(&models.Album{}).Unscoped().Where("id = ?", album.ID).Update("deleted_at", nil).Error; err != nil {
+		t.Fatal(err)
+	}
+	adminAlbum := newTestApp(db, "GET", "/albums/:id", 1, "admin", GetAlbumHandler)
+	status, body = doRequest(t, adminAlbum, "GET", albumPath, nil)
+	if status != 200 {
+		t.Fatalf("album status = %d, body = %v", status, body)
+	}
+	if artistData, _ := dataMap(t, body)["artist_data"].(map[string]interface{}); artistData == nil || artistData["name"] != "Retired" {
+		t.Errorf("admin artist_data = %v, want deleted artist Retired", dataMap(t, body)["artist_data"])
+	}
+	userAlbum := newTestApp(db, "GET", "/albums/:id", 2, "user", GetAlbumHandler)
+	status, body = doRequest(t, userAlbum, "GET", albumPath, nil)
+	if status != 200 {
+		t.Fatalf("album status = %d, body = %v", status, body)
+	}
+	if _, ok := dataMap(t, body)["artist_data"]; ok {
+		t.Errorf("user sees deleted album artist: %v", dataMap(t, body)["artist_data"])
+	}
+}
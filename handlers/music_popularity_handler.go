@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"backend_soundcave/config"
+	"backend_soundcave/models"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// Popularity score music (0-100):
+//
+//	raw        = POPULARITY_WEIGHT_PLAYS * play dalam POPULARITY_WINDOW_DAYS terakhir
+//	           + POPULARITY_WEIGHT_LIKES * like_count
+//	           + POPULARITY_WEIGHT_PLAYLISTS * jumlah playlist yang memuat music
+//	popularity = 100 * raw / raw tertinggi di seluruh music (dibulatkan 1 desimal)
+const popularityRawScoreExpr = "? * COALESCE(pe.plays, 0) + ? * COALESCE(musics.like_count, 0) + ? * COALESCE(ps.playlists, 0)"
+
+// popularityMaxCacheTTL lama raw score tertinggi disimpan di memory agar detail music
+// tidak menjalankan agregasi seluruh katalog di setiap request
+const popularityMaxCacheTTL = 60 * time.Second
+
+// popularityMaxCacheEntry menyimpan raw score tertinggi untuk satu konfigurasi bobot
+type popularityMaxCacheEntry struct {
+	cfg       config.PopularityConfig
+	maxScore  float64
+	expiresAt time.Time
+}
+
+var (
+	popularityMaxCache   *popularityMaxCacheEntry
+	popularityMaxCacheMu sync.RWMutex
+)
+
+// invalidatePopularityMaxCache menghapus cache raw score tertinggi
+func invalidatePopularityMaxCache() {
+	popularityMaxCacheMu.Lock()
+	popularityMaxCache = nil
+	popularityMaxCacheMu.Unlock()
+}
+
+// MusicPopularity struct untuk music beserta popularity score
+type MusicPopularity struct {
+	models.Music
+	Popularity float64 `json:"popularity"`
+}
+
+// popularityRow struct hasil perhitungan raw score per music
+type popularityRow struct {
+	MusicID  uint
+	RawScore float64
+}
+
+// popularityQuery membangun query seluruh musics yang di-join dengan play terbaru dan jumlah playlist.
+// Hanya dipakai untuk raw score tertinggi dan top list; detail music memakai musicRawPopularityScore.
+func popularityQuery(db *gorm.DB, cfg config.PopularityConfig) *gorm.DB {
+	since := time.Now().AddDate(0, 0, -cfg.WindowDays)
+
+	return db.Table("musics").
+		Joins("LEFT JOIN (SELECT music_id, COUNT(*) AS plays FROM play_events WHERE created_at >= ? GROUP BY music_id) pe ON pe.music_id = musics.id", since).
+		Joins("LEFT JOIN (SELECT music_id, COUNT(DISTINCT playlist_id) AS playlists FROM playlist_songs WHERE deleted_at IS NULL GROUP BY music_id) ps ON ps.music_id = musics.id").
+		Where("musics.deleted_at IS NULL")
+}
+
+// popularityRawScoreSelect memilih id music dan raw score sesuai bobot
+func popularityRawScoreSelect(query *gorm.DB, cfg config.PopularityConfig) *gorm.DB {
+	return query.Select("musics.id AS music_id, ("+popularityRawScoreExpr+") AS raw_score",
+		cfg.PlaysWeight, cfg.LikesWeight, cfg.PlaylistsWeight)
+}
+
+// maxPopularityRawScore mengambil raw score tertinggi sebagai acuan normalisasi
+func maxPopularityRawScore(db *gorm.DB, cfg config.PopularityConfig) (float64, error) {
+	var maxScore *float64
+	err := popularityQuery(db, cfg).
+		Select("MAX("+popularityRawScoreExpr+")", cfg.PlaysWeight, cfg.LikesWeight, cfg.PlaylistsWeight).
+		Scan(&maxScore).Error
+	if err != nil || maxScore == nil {
+		return 0, err
+	}
+	return *maxScore, nil
+}
+
+// cachedMaxPopularityRawScore mengambil raw score tertinggi dari cache, dihitung ulang setelah TTL habis
+func cachedMaxPopularityRawScore(db *gorm.DB, cfg config.PopularityConfig) (float64, error) {
+	popularityMaxCacheMu.RLock()
+	entry := popularityMaxCache
+	popularityMaxCacheMu.RUnlock()
+
+	if entry != nil && entry.cfg == cfg && time.Now().Before(entry.expiresAt) {
+		return entry.maxScore, nil
+	}
+
+	maxScore, err := maxPopularityRawScore(db, cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	popularityMaxCacheMu.Lock()
+	popularityMaxCache = &popularityMaxCacheEntry{
+		cfg:       cfg,
+		maxScore:  maxScore,
+		expiresAt: time.Now().Add(popularityMaxCacheTTL),
+	}
+	popularityMaxCacheMu.Unlock()
+
+	return maxScore, nil
+}
+
+// normalizePopularity mengubah raw score menjadi skala 0-100.
+// Raw score di atas acuan (cache yang belum diperbarui) dibatasi 100.
+func normalizePopularity(rawScore, maxScore float64) float64 {
+	if maxScore <= 0 || rawScore <= 0 {
+		return 0
+	}
+	if rawScore >= maxScore {
+		return 100
+	}
+	return math.Round(rawScore/maxScore*1000) / 10
+}
+
+// musicRawPopularityScore menghitung raw score satu music dengan subquery yang difilter per music_id,
+// sehingga tidak mengagregasi seluruh play_events dan playlist_songs
+func musicRawPopularityScore(db *gorm.DB, cfg config.PopularityConfig, musicID uint) (float64, error) {
+	since := time.Now().AddDate(0, 0, -cfg.WindowDays)
+
+	var rawScore *float64
+	err := db.Table("musics").
+		Select("? * (SELECT COUNT(*) FROM play_events WHERE play_events.music_id = musics.id AND play_events.created_at >= ?)"+
+			" + ? * COALESCE(musics.like_count, 0)"+
+			" + ? * (SELECT COUNT(DISTINCT playlist_id) FROM playlist_songs WHERE playlist_songs.music_id = musics.id AND playlist_songs.deleted_at IS NULL)",
+			cfg.PlaysWeight, since, cfg.LikesWeight, cfg.PlaylistsWeight).
+		Where("musics.id = ? AND musics.deleted_at IS NULL", musicID).
+		Scan(&rawScore).Error
+	if err != nil || rawScore == nil {
+		return 0, err
+	}
+	return *rawScore, nil
+}
+
+// musicPopularity menghitung popularity score satu music
+func musicPopularity(db *gorm.DB, musicID uint) (float64, error) {
+	cfg := config.GetPopularityConfig()
+
+	rawScore, err := musicRawPopularityScore(db, cfg, musicID)
+	if err != nil {
+		return 0, err
+	}
+
+	maxScore, err := cachedMaxPopularityRawScore(db, cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	return normalizePopularity(rawScore, maxScore), nil
+}
+
+// GetTopMusicsByPopularityHandler mendapatkan musics dengan popularity score tertinggi
+// @Summary      Get top musics by popularity
+// @Description  Get musics ranked by a 0-100 popularity score. raw = POPULARITY_WEIGHT_PLAYS * plays in the last POPULARITY_WINDOW_DAYS + POPULARITY_WEIGHT_LIKES * like_count + POPULARITY_WEIGHT_PLAYLISTS * playlist inclusions; popularity = 100 * raw / highest raw across all musics
+// @Tags         Musics
+// @Accept       json
+// @Produce      json
+// @Param        limit  query     int  false  "Number of musics (max PAGINATION_MAX_LIMIT)" default(10)
+// @Success      200    {object}  map[string]interface{}
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /musics/top-by-popularity [get]
+func GetTopMusicsByPopularityHandler(c *fiber.Ctx, db *gorm.DB) error {
+	cfg := config.GetPopularityConfig()
+
+	limit := c.QueryInt("limit", 10)
+	if limit < 1 {
+		limit = 10
+	}
+	if maxLimit := config.GetPaginationMaxLimit(); limit > maxLimit {
+		limit = maxLimit
+	}
+
+	var rows []popularityRow
+	if err := popularityRawScoreSelect(popularityQuery(db, cfg), cfg).
+		Order("raw_score DESC, musics.id ASC").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghitung popularity musics",
+			"error":   err.Error(),
+		})
+	}
+
+	// Hasil sudah diurutkan dari raw score tertinggi, baris pertama menjadi acuan normalisasi
+	var maxScore float64
+	if len(rows) > 0 {
+		maxScore = rows[0].RawScore
+	}
+
+	musicIDs := make([]uint, 0, len(rows))
+	for _, row := range rows {
+		musicIDs = append(musicIDs, row.MusicID)
+	}
+
+	var musics []models.Music
+	if len(musicIDs) > 0 {
+		if err := db.Where("id IN ?", musicIDs).Find(&musics).Error; err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil data musics",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	// Pertahankan urutan berdasarkan raw score
+	byID := make(map[uint]models.Music, len(musics))
+	for _, music := range musics {
+		byID[music.ID] = music
+	}
+
+	results := make([]MusicPopularity, 0, len(rows))
+	for _, row := range rows {
+		music, ok := byID[row.MusicID]
+		if !ok {
+			continue
+		}
+		results = append(results, MusicPopularity{
+			Music:      music,
+			Popularity: normalizePopularity(row.RawScore, maxScore),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    results,
+		"count":   len(results),
+	})
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestPopularityScoresRecentPlaysHigher(t *testing.T) {
+	invalidatePopularityMaxCache()
+	t.Cleanup(invalidatePopularityMaxCache)
+
+	db := testdb.New(t)
+	hot := testMusic("Hot", 1)
+	warm := testMusic("Warm", 1)
+	cold := testMusic("Cold", 1)
+	mustCreate(t, db, &hot)
+	mustCreate(t, db, &warm)
+	mustCreate(t, db, &cold)
+
+	var events []models.PlayEvent
+	for i := 0; i < 5; i++ {
+		events = append(events, models.PlayEvent{MusicID: hot.ID, UserID: 1})
+	}
+	events = append(events, models.PlayEvent{MusicID: warm.ID, UserID: 1}, models.PlayEvent{MusicID: warm.ID, UserID: 2})
+	// Play lama di luar POPULARITY_WINDOW_DAYS tidak dihitung
+	for i := 0; i < 10; i++ {
+		events = append(events, models.PlayEvent{MusicID: cold.ID, UserID: 1, CreatedAt: time.Now().AddDate(0, 0, -60)})
+	}
+	mustCreate(t, db, &events)
+
+	detail := newTestApp(db, "GET", "/musics/:id", 1, "user", GetMusicHandler)
+	popularity := func(music models.Music) float64 {
+		t.Helper()
+		status, body := doRequest(t, detail, "GET", fmt.Sprintf("/musics/%d", music.ID), nil)
+		if status != 200 {
+			t.Fatalf("status = %d, body = %v", status, body)
+		}
+		return dataMap(t, body)["popularity"].(float64)
+	}
+
+	hotScore, warmScore, coldScore := popularity(hot), popularity(warm), popularity(cold)
+	if hotScore != 100 || warmScore != 40 || coldScore != 0 {
+		t.Errorf("popularity = hot %v, warm %v, cold %v, want 100/40/0", hotScore, warmScore, coldScore)
+	}
+
+	top := newTestApp(db, "GET", "/musics/top-by-popularity", 1, "user", GetTopMusicsByPopularityHandler)
+	status, body := doRequest(t, top, "GET", "/musics/top-by-popularity?limit=2", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	want := []string{"Hot", "Warm"}
+	if got := musicTitles(t, body); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("top titles = %v, want %v", got, want)
+	}
+	if first := dataList(t, body)[0]["popularity"]; first != float64(100) {
+		t.Errorf("top popularity = %v, want 100", first)
+	}
+}
+
+func TestMusicPopularityCountsLikesAndPlaylists(t *testing.T) {
+	invalidatePopularityMaxCache()
+	t.Cleanup(invalidatePopularityMaxCache)
+
+	db := testdb.New(t)
+	listed := testMusic("Listed", 1)
+	listed.LikeCount = intPtr(1)
+	played := testMusic("Played", 1)
+	mustCreate(t, db, &listed)
+	mustCreate(t, db, &played)
+
+	// Listed: 1 like (2) + 2 playlist berbeda (6) + play lama (0) = 8, Played: 10 play terbaru = 10
+	mustCreate(t, db, &[]models.PlaylistSong{
+		{PlaylistID: 1, MusicID: listed.ID},
+		{PlaylistID: 1, MusicID: listed.ID},
+		{PlaylistID: 2, MusicID: listed.ID},
+	})
+	events := []models.PlayEvent{{MusicID: listed.ID, UserID: 1, CreatedAt: time.Now().AddDate(0, 0, -60)}}
+	for i := 0; i < 10; i++ {
+		events = append(events, models.PlayEvent{MusicID: played.ID, UserID: 1})
+	}
+	mustCreate(t, db, &events)
+
+	detail := newTestApp(db, "GET", "/musics/:id", 1, "user", GetMusicHandler)
+	status, body := doRequest(t, detail, "GET", fmt.Sprintf("/musics/%d", listed.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got := dataMap(t, body)["popularity"]; got != float64(80) {
+		t.Errorf("listed popularity = %v, want 80", got)
+	}
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// findMusicVersionRoot mengembalikan ID music utama dari sebuah music.
+// Semua versi selalu menginduk langsung ke music utama sehingga tidak ada rantai bertingkat.
+func findMusicVersionRoot(db *gorm.DB, musicID uint) (uint, error) {
+	var music models.Music
+	if err := db.Select("id", "parent_music_id").First(&music, musicID).Error; err != nil {
+		return 0, err
+	}
+	if music.ParentMusicID != nil {
+		return *music.ParentMusicID, nil
+	}
+	return music.ID, nil
+}
+
+// moveMusicVersions memindahkan versi yang menginduk ke music sebelum music tersebut dihapus.
+// Jika newRootID nil, versi tertua dipromosikan menjadi music utama baru dan versi lainnya menginduk ke sana.
+func moveMusicVersions(tx *gorm.DB, musicID uint, newRootID *uint) error {
+	if newRootID == nil {
+		var oldest models.Music
+		if err := tx.Select("id").Where("parent_music_id = ?", musicID).Order("id ASC").First(&oldest).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil
+			}
+			return err
+		}
+		if err := tx.Model(&models.Music{}).Where("id = ?", oldest.ID).Update("parent_music_id", nil).Error; err != nil {
+			return err
+		}
+		newRootID = &oldest.ID
+	}
+	return tx.Model(&models.Music{}).Where("parent_music_id = ?", musicID).Update("parent_music_id", *newRootID).Error
+}
+
+// applySafeVersions mengganti music explicit dengan versi clean dari lagu yang sama jika tersedia.
+// filters berisi kondisi list (boleh nil) sehingga versi clean yang tidak lolos filter tidak ikut tampil.
+// Music yang sama tidak ditampilkan dua kali, misalnya jika versi clean sudah ada di halaman yang sama.
+func applySafeVersions(db *gorm.DB, filters *gorm.DB, musics []models.Music) ([]models.Music, error) {
+	var rootIDs []uint
+	for _, music := range musics {
+		if music.Explicit == nil || !*music.Explicit {
+			continue
+		}
+		if music.ParentMusicID != nil {
+			rootIDs = append(rootIDs, *music.ParentMusicID)
+		} else {
+			rootIDs = append(rootIDs, music.ID)
+		}
+	}
+	if len(rootIDs) == 0 {
+		return musics, nil
+	}
+
+	cleanQuery := db.Model(&models.Music{})
+	if filters != nil {
+		cleanQuery = cleanQuery.Where(filters)
+	}
+
+	var cleanVersions []models.Music
+	if err := cleanQuery.Where("(id IN ? OR parent_music_id IN ?) AND (explicit = ? OR explicit IS NULL)", rootIDs, rootIDs, false).
+		Order("id ASC").
+		Find(&cleanVersions).Error; err != nil {
+		return nil, err
+	}
+
+	// Ambil satu versi clean untuk setiap music utama
+	cleanByRoot := make(map[uint]models.Music)
+	for _, clean := range cleanVersions {
+		rootID := clean.ID
+		if clean.ParentMusicID != nil {
+			rootID = *clean.ParentMusicID
+		}
+		if _, exists := cleanByRoot[rootID]; !exists {
+			cleanByRoot[rootID] = clean
+		}
+	}
+
+	result := make([]models.Music, 0, len(musics))
+	seen := make(map[uint]bool)
+	for _, music := range musics {
+		if music.Explicit != nil && *music.Explicit {
+			rootID := music.ID
+			if music.ParentMusicID != nil {
+				rootID = *music.ParentMusicID
+			}
+			if clean, ok := cleanByRoot[rootID]; ok {
+				music = clean
+			}
+		}
+		if seen[music.ID] {
+			continue
+		}
+		seen[music.ID] = true
+		result = append(result, music)
+	}
+
+	return result, nil
+}
+
+// GetMusicVersionsHandler mendapatkan semua versi dari sebuah music
+// @Summary      Get music versions
+// @Description  Get every linked version (e.g. explicit and clean) of the same track, the main version first
+// @Tags         Musics
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Music ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /musics/{id}/versions [get]
+func GetMusicVersionsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+
+	var music models.Music
+	if err := db.First(&music, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Music tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data music",
+			"error":   err.Error(),
+		})
+	}
+
+	rootID := music.ID
+	if music.ParentMusicID != nil {
+		rootID = *music.ParentMusicID
+	}
+
+	var versions []models.Music
+	if err := db.Where("id = ? OR parent_music_id = ?", rootID, rootID).
+		Order(musicFirstOrder(rootID)).
+		Order("id ASC").
+		Find(&versions).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data versi music",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success":       true,
+		"data":          versions,
+		"root_music_id": rootID,
+		"count":         len(versions),
+	})
+}
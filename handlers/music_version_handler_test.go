@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// seedVersionPair membuat music explicit beserta versi clean-nya
+func seedVersionPair(t *testing.T, db *gorm.DB, title string, cleanApproved int) (models.Music, models.Music) {
+	t.Helper()
+	explicit := testMusic(title, 1)
+	explicit.Explicit = boolPtr(true)
+	explicit.IsApproved = intPtr(1)
+	mustCreate(t, db, &explicit)
+
+	clean := testMusic(title+" (Clean)", 1)
+	clean.Explicit = boolPtr(false)
+	clean.IsApproved = intPtr(cleanApproved)
+	clean.ParentMusicID = &explicit.ID
+	mustCreate(t, db, &clean)
+	return explicit, clean
+}
+
+func TestSafeModeReturnsCleanVersion(t *testing.T) {
+	db := testdb.New(t)
+	explicit, clean := seedVersionPair(t, db, "Song", 1)
+
+	app := newTestApp(db, "GET", "/musics/:id", 1, "user", GetMusicHandler)
+	status, body := doRequest(t, app, "GET", fmt.Sprintf("/musics/%d?safe=true", explicit.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got := dataMap(t, body)["id"]; got != float64(clean.ID) {
+		t.Errorf("safe detail id = %v, want clean version %d", got, clean.ID)
+	}
+
+	status, body = doRequest(t, app, "GET", fmt.Sprintf("/musics/%d", explicit.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got := dataMap(t, body)["id"]; got != float64(explicit.ID) {
+		t.Errorf("detail id without safe = %v, want explicit version %d", got, explicit.ID)
+	}
+}
+
+func TestSafeModeListDedupesAndAppliesFilters(t *testing.T) {
+	db := testdb.New(t)
+	seedVersionPair(t, db, "Linked", 1)
+	seedVersionPair(t, db, "Pending", 0)
+
+	app := newTestApp(db, "GET", "/musics", 1, "user", GetMusicsHandler)
+
+	// Kedua versi ada di halaman yang sama, versi clean hanya tampil sekali
+	status, body := doRequest(t, app, "GET", "/musics?safe=true&search=Linked", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got, want := musicTitles(t, body), []string{"Linked (Clean)"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("safe search titles = %v, want %v", got, want)
+	}
+
+	// Versi clean yang belum di-approve tidak lolos filter, versi explicit tetap tampil
+	status, body = doRequest(t, app, "GET", "/musics?safe=true&is_approved=1&order=asc&sort_by=id", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got, want := musicTitles(t, body), []string{"Linked (Clean)", "Pending"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("safe approved titles = %v, want %v", got, want)
+	}
+}
+
+func TestGetMusicVersionsHandlerRootFirst(t *testing.T) {
+	db := testdb.New(t)
+	first := testMusic("First", 1)
+	mustCreate(t, db, &first)
+	second := testMusic("Second", 1)
+	second.ParentMusicID = &first.ID
+	mustCreate(t, db, &second)
+	root := testMusic("Root", 1)
+	mustCreate(t, db, &root)
+
+	// Memindahkan First ke bawah Root ikut memindahkan Second
+	update := newTestApp(db, "PUT", "/musics/:id", 1, "admin", UpdateMusicHandler)
+	status, body := doRequest(t, update, "PUT", fmt.Sprintf("/musics/%d", first.ID), map[string]interface{}{
+		"parent_music_id": root.ID,
+	})
+	if status != 200 {
+		t.Fatalf("update status = %d, body = %v", status, body)
+	}
+
+	var moved models.Music
+	if err := db.First(&moved, second.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if moved.ParentMusicID == nil || *moved.ParentMusicID != root.ID {
+		t.Errorf("second parent_music_id = %v, want %d", moved.ParentMusicID, root.ID)
+	}
+
+	app := newTestApp(db, "GET", "/musics/:id/versions", 1, "user", GetMusicVersionsHandler)
+	status, body = doRequest(t, app, "GET", fmt.Sprintf("/musics/%d/versions", second.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got, want := musicTitles(t, body), []string{"Root", "First", "Second"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("version titles = %v, want %v", got, want)
+	}
+}
+
+func TestDeleteMusicPromotesOldestVersion(t *testing.T) {
+	db := testdb.New(t)
+	root := testMusic("Root", 1)
+	mustCreate(t, db, &root)
+	oldest := testMusic("Oldest", 1)
+	oldest.ParentMusicID = &root.ID
+	mustCreate(t, db, &oldest)
+	newest := testMusic("Newest", 1)
+	newest.ParentMusicID = &root.ID
+	mustCreate(t, db, &newest)
+
+	remove := newTestApp(db, "DELETE", "/musics/:id", 1, "admin", DeleteMusicHandler)
+	if status, body := doRequest(t, remove, "DELETE", fmt.Sprintf("/musics/%d", root.ID), nil); status != 200 {
+		t.Fatalf("delete status = %d, body = %v", status, body)
+	}
+
+	app := newTestApp(db, "GET", "/musics/:id/versions", 1, "user", GetMusicVersionsHandler)
+	status, body := doRequest(t, app, "GET", fmt.Sprintf("/musics/%d/versions", newest.ID), nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got := body["root_music_id"]; got != float64(oldest.ID) {
+		t.Errorf("root_music_id = %v, want promoted version %d", got, oldest.ID)
+	}
+	if got, want := musicTitles(t, body), []string{"Oldest", "Newest"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("version titles = %v, want %v", got, want)
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -82,20 +83,31 @@ func CreateMusicVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		releaseDatePtr = &releaseDate
 	}
 
+	// Ukuran file diambil dari catatan upload, bukan dari client
+	videoFileSize, err := uploadedFileSize(db, req.VideoURL)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil ukuran file video",
+			"error":   err.Error(),
+		})
+	}
+
 	// Buat music_video baru
 	musicVideo := models.MusicVideo{
-		Title:       req.Title,
-		ArtistID:    req.ArtistID,
-		Artist:      req.Artist,
-		ReleaseDate: releaseDatePtr,
-		Duration:    req.Duration,
-		Genre:       req.Genre,
-		Description: req.Description,
-		VideoURL:    req.VideoURL,
-		Thumbnail:   req.Thumbnail,
-		SubmittedBy: "artist",
-		IsApproved:  new(int), // Default 0
-		IsHighlight: new(int), // Default 0
+		Title:         req.Title,
+		ArtistID:      req.ArtistID,
+		Artist:        req.Artist,
+		ReleaseDate:   releaseDatePtr,
+		Duration:      req.Duration,
+		Genre:         req.Genre,
+		Description:   req.Description,
+		VideoURL:      req.VideoURL,
+		VideoFileSize: videoFileSize,
+		Thumbnail:     req.Thumbnail,
+		SubmittedBy:   "artist",
+		IsApproved:    new(int), // Default 0
+		IsHighlight:   new(int), // Default 0
 	}
 	*musicVideo.IsApproved = 0
 	*musicVideo.IsHighlight = 0
@@ -143,6 +155,7 @@ func CreateMusicVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        is_highlight query   int     false  "Filter by highlight status (0 or 1)"
 // @Param        submitted_by query   string  false  "Filter by submitter (artist, label, admin)"
 // @Success      200        {object}  map[string]interface{}
+// @Failure      400        {object}  map[string]interface{}
 // @Failure      401        {object}  map[string]interface{}
 // @Failure      500        {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -190,9 +203,12 @@ func GetMusicVideosHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	// Sort by created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
@@ -343,6 +359,15 @@ func UpdateMusicVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	if req.VideoURL != nil {
 		musicVideo.VideoURL = *req.VideoURL
+		videoFileSize, err := uploadedFileSize(db, *req.VideoURL)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengambil ukuran file video",
+				"error":   err.Error(),
+			})
+		}
+		musicVideo.VideoFileSize = videoFileSize
 	}
 
 	if req.Thumbnail != nil {
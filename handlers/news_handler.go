@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
 	"strconv"
 	"time"
 
@@ -142,10 +143,12 @@ func CreateNewsHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        category     query     string  false  "Filter by category"
 // @Param        author       query     string  false  "Filter by author"
 // @Param        published    query     bool    false  "Filter by published status"
-// @Param        search       query     string  false  "Search by title or content"
+// @Param        search       query     string  false  "Search by title or content. Search results are trimmed and omit content"
+// @Param        expand       query     string  false  "Include omitted fields in search results (content)"
 // @Param        sort_by      query     string  false  "Sort field" default(created_at)
 // @Param        order        query     string  false  "Sort order" default(desc)
 // @Success      200          {object}  map[string]interface{}
+// @Failure      400          {object}  map[string]interface{}
 // @Failure      401          {object}  map[string]interface{}
 // @Failure      500          {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -158,6 +161,14 @@ func GetNewsHandler(c *fiber.Ctx, db *gorm.DB) error {
 	limit := c.QueryInt("limit", 10)
 	offset := (page - 1) * limit
 
+	expand, err := utils.ParseExpand(c.Query("expand"), "content")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
+	}
+
 	// Query dengan pagination
 	query := db.Model(&models.News{})
 
@@ -180,15 +191,19 @@ func GetNewsHandler(c *fiber.Ctx, db *gorm.DB) error {
 	}
 
 	// Search by title, content, atau summary
-	if search := c.Query("search"); search != "" {
+	search := c.Query("search")
+	if search != "" {
 		query = query.Where("title LIKE ? OR content LIKE ? OR summary LIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
 	}
 
 	// Sort by created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
@@ -205,9 +220,15 @@ func GetNewsHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// Hasil search diringkas, content hanya dikirim jika diminta
+	var data interface{} = news
+	if search != "" {
+		data = toNewsSearchResults(news, expand["content"])
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
-		"data":    news,
+		"data":    data,
 		"pagination": fiber.Map{
 			"page":  page,
 			"limit": limit,
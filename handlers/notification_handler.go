@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
 	"strconv"
 	"time"
 
@@ -29,6 +30,33 @@ type UpdateNotificationRequest struct {
 	Type    *string `json:"type" validate:"omitempty,oneof=info success warning error"`
 }
 
+// UpdateNotificationPreferenceRequest struct untuk request update notification preferences
+type UpdateNotificationPreferenceRequest struct {
+	InfoEnabled    *bool `json:"info_enabled"`
+	SuccessEnabled *bool `json:"success_enabled"`
+	WarningEnabled *bool `json:"warning_enabled"`
+	ErrorEnabled   *bool `json:"error_enabled"`
+}
+
+// findNotificationPreference mengambil preferences user, default semua aktif jika belum pernah diatur
+func findNotificationPreference(db *gorm.DB, userID uint) (models.NotificationPreference, error) {
+	enabled := true
+	preference := models.NotificationPreference{
+		UserID:         userID,
+		InfoEnabled:    &enabled,
+		SuccessEnabled: &enabled,
+		WarningEnabled: &enabled,
+		ErrorEnabled:   &enabled,
+	}
+
+	err := db.Where("user_id = ?", userID).First(&preference).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return preference, err
+	}
+
+	return preference, nil
+}
+
 // CreateNotificationHandler membuat notification baru
 // @Summary      Create new notification
 // @Description  Create a new notification
@@ -36,6 +64,7 @@ type UpdateNotificationRequest struct {
 // @Accept       json
 // @Produce      json
 // @Param        request  body      CreateNotificationRequest  true  "Notification Request"
+// @Success      200      {object}  map[string]interface{}  "Skipped because the user disabled this type"
 // @Success      201      {object}  map[string]interface{}
 // @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
@@ -89,6 +118,23 @@ func CreateNotificationHandler(c *fiber.Ctx, db *gorm.DB) error {
 		}
 	}
 
+	// Lewati notification jika user menonaktifkan type ini
+	preference, err := findNotificationPreference(db, uint(req.UserID))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil notification preferences",
+			"error":   err.Error(),
+		})
+	}
+	if !preference.IsTypeEnabled(notificationType) {
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{
+			"success": true,
+			"message": "Notification tidak dibuat karena type ini dinonaktifkan oleh user",
+			"skipped": true,
+		})
+	}
+
 	// Buat notification baru
 	notification := models.Notification{
 		UserID:  req.UserID,
@@ -120,25 +166,22 @@ func CreateNotificationHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Tags         Notifications
 // @Accept       json
 // @Produce      json
-// @Param        page     query     int     false  "Page number" default(1)
-// @Param        limit    query     int     false  "Items per page" default(10)
-// @Param        type     query     string  false  "Filter by type"
-// @Param        search   query     string  false  "Search by title or message"
-// @Param        sort_by  query     string  false  "Sort field" default(created_at)
-// @Param        order    query     string  false  "Sort order" default(desc)
-// @Success      200      {object}  map[string]interface{}
-// @Failure      401      {object}  map[string]interface{}
-// @Failure      500      {object}  map[string]interface{}
+// @Param        page        query     int     false  "Page number" default(1)
+// @Param        limit       query     int     false  "Items per page (max PAGINATION_MAX_LIMIT)" default(10)
+// @Param        skip_total  query     bool    false  "Skip total count and return has_more only" default(false)
+// @Param        type        query     string  false  "Filter by type"
+// @Param        search      query     string  false  "Search by title or message"
+// @Param        sort_by     query     string  false  "Sort field" default(created_at)
+// @Param        order       query     string  false  "Sort order" default(desc)
+// @Success      200         {object}  map[string]interface{}
+// @Failure      400         {object}  map[string]interface{}
+// @Failure      401         {object}  map[string]interface{}
+// @Failure      500         {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /notifications [get]
 func GetNotificationsHandler(c *fiber.Ctx, db *gorm.DB) error {
 	var notifications []models.Notification
 
-	// Pagination
-	page := c.QueryInt("page", 1)
-	limit := c.QueryInt("limit", 10)
-	offset := (page - 1) * limit
-
 	// Query dengan pagination
 	query := db.Model(&models.Notification{})
 
@@ -167,18 +210,18 @@ func GetNotificationsHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	// Sort by created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
-	// Get total count
-	var total int64
-	query.Count(&total)
-
 	// Get notifications
-	if err := query.Offset(offset).Limit(limit).Find(&notifications).Error; err != nil {
+	pagination, err := paginateQuery(c, query, &notifications)
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal mengambil data notifications",
@@ -187,14 +230,9 @@ func GetNotificationsHandler(c *fiber.Ctx, db *gorm.DB) error {
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"success": true,
-		"data":    notifications,
-		"pagination": fiber.Map{
-			"page":  page,
-			"limit": limit,
-			"total": total,
-			"pages": (int(total) + limit - 1) / limit,
-		},
+		"success":    true,
+		"data":       notifications,
+		"pagination": pagination,
 	})
 }
 
@@ -237,20 +275,23 @@ func GetNotificationHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 // GetUserNotificationsHandler mendapatkan notifications by user_id
 // @Summary      Get user notifications
-// @Description  Get paginated list of notifications for a specific user
+// @Description  Get paginated list of notifications for a specific user. For incremental sync pass since_id to get only newer notifications in ascending id order, at most limit per call; max_id in the response is the since_id for the next sync
 // @Tags         Notifications
 // @Accept       json
 // @Produce      json
-// @Param        user_id  path      int     true  "User ID"
-// @Param        page     query     int     false  "Page number" default(1)
-// @Param        limit    query     int     false  "Items per page" default(10)
-// @Param        is_read  query     bool    false  "Filter by read status"
-// @Param        type     query     string  false  "Filter by type"
-// @Param        sort_by  query     string  false  "Sort field" default(created_at)
-// @Param        order    query     string  false  "Sort order" default(desc)
-// @Success      200      {object}  map[string]interface{}
-// @Failure      401      {object}  map[string]interface{}
-// @Failure      500      {object}  map[string]interface{}
+// @Param        user_id     path      int     true  "User ID"
+// @Param        page        query     int     false  "Page number (ignored when since_id is set)" default(1)
+// @Param        limit       query     int     false  "Items per page (max PAGINATION_MAX_LIMIT)" default(10)
+// @Param        skip_total  query     bool    false  "Skip total count and return has_more only" default(false)
+// @Param        is_read     query     bool    false  "Filter by read status"
+// @Param        type        query     string  false  "Filter by type"
+// @Param        since_id    query     int     false  "Only notifications with id greater than this, ordered by id ascending (page, sort_by and order are ignored)"
+// @Param        sort_by     query     string  false  "Sort field" default(created_at)
+// @Param        order       query     string  false  "Sort order" default(desc)
+// @Success      200         {object}  map[string]interface{}
+// @Failure      400         {object}  map[string]interface{}
+// @Failure      401         {object}  map[string]interface{}
+// @Failure      500         {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /notifications/user/{user_id} [get]
 func GetUserNotificationsHandler(c *fiber.Ctx, db *gorm.DB) error {
@@ -258,11 +299,6 @@ func GetUserNotificationsHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	var notifications []models.Notification
 
-	// Pagination
-	page := c.QueryInt("page", 1)
-	limit := c.QueryInt("limit", 10)
-	offset := (page - 1) * limit
-
 	// Query dengan pagination
 	query := db.Model(&models.Notification{}).Where("user_id = ?", userID)
 
@@ -279,20 +315,40 @@ func GetUserNotificationsHandler(c *fiber.Ctx, db *gorm.DB) error {
 		query = query.Where("type = ?", notificationType)
 	}
 
-	// Sort by created_at
-	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	// Sync incremental: hanya notifications setelah since_id, urut id naik
+	var sinceID uint64
+	if since := c.Query("since_id"); since != "" {
+		parsed, err := strconv.ParseUint(since, 10, 32)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"message": "since_id tidak valid",
+			})
+		}
+		sinceID = parsed
+		query = query.Where("id > ?", sinceID).Order("id ASC")
+	} else {
+		// Sort by created_at
+		sortBy := c.Query("sort_by", "created_at")
+		order, err := utils.ParseOrder(c.Query("order"), "desc")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"message": err.Error(),
+			})
+		}
+		query = query.Order(sortBy + " " + order)
 	}
-	query = query.Order(sortBy + " " + order)
 
-	// Get total count
-	var total int64
-	query.Count(&total)
-
-	// Get notifications
-	if err := query.Offset(offset).Limit(limit).Find(&notifications).Error; err != nil {
+	// Get notifications, sync since_id hanya memakai limit (page diabaikan)
+	var pagination fiber.Map
+	var err error
+	if c.Query("since_id") != "" {
+		pagination, err = limitQuery(c, query, &notifications)
+	} else {
+		pagination, err = paginateQuery(c, query, &notifications)
+	}
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal mengambil data notifications",
@@ -300,15 +356,19 @@ func GetUserNotificationsHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// max_id dipakai client sebagai since_id pada sync berikutnya
+	maxID := uint(sinceID)
+	for _, notification := range notifications {
+		if notification.ID > maxID {
+			maxID = notification.ID
+		}
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"success": true,
-		"data":    notifications,
-		"pagination": fiber.Map{
-			"page":  page,
-			"limit": limit,
-			"total": total,
-			"pages": (int(total) + limit - 1) / limit,
-		},
+		"success":    true,
+		"data":       notifications,
+		"pagination": pagination,
+		"max_id":     maxID,
 	})
 }
 
@@ -499,6 +559,214 @@ func MarkAllAsReadHandler(c *fiber.Ctx, db *gorm.DB) error {
 	})
 }
 
+// DeleteUserNotificationsHandler menghapus (soft delete) notifications user, opsional per type
+// @Summary      Delete user notifications
+// @Description  Soft delete all notifications of a user, optionally only those of a given type. Only the owner or an admin can do this
+// @Tags         Notifications
+// @Accept       json
+// @Produce      json
+// @Param        user_id  path      int     true   "User ID"
+// @Param        type     query     string  false  "Only delete notifications of this type (info, success, warning, error)"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /notifications/user/{user_id} [delete]
+func DeleteUserNotificationsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	userID, err := strconv.ParseUint(c.Params("user_id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "User ID tidak valid",
+		})
+	}
+
+	// Hanya pemilik notifications atau admin yang boleh menghapus
+	currentUserID, _ := c.Locals("user_id").(uint)
+	role, _ := c.Locals("role").(string)
+	if uint(userID) != currentUserID && role != "admin" {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Anda tidak memiliki akses untuk menghapus notifications user ini",
+		})
+	}
+
+	query := db.Where("user_id = ?", userID)
+
+	// Filter by type jika ada
+	if notificationType := c.Query("type"); notificationType != "" {
+		switch models.NotificationType(notificationType) {
+		case models.NotificationTypeInfo, models.NotificationTypeSuccess, models.NotificationTypeWarning, models.NotificationTypeError:
+			query = query.Where("type = ?", notificationType)
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"message": "type harus info, success, warning, atau error",
+			})
+		}
+	}
+
+	result := query.Delete(&models.Notification{})
+	if result.Error != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghapus notifications",
+			"error":   result.Error.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Notifications berhasil dihapus",
+		"count":   result.RowsAffected,
+	})
+}
+
+// GetUnreadNotificationsByTypeHandler mendapatkan jumlah notifications belum dibaca per type
+// @Summary      Get unread notification counts by type
+// @Description  Get the number of unread notifications for a specific user grouped by type
+// @Tags         Notifications
+// @Accept       json
+// @Produce      json
+// @Param        user_id  path      int  true  "User ID"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /notifications/user/{user_id}/unread-by-type [get]
+func GetUnreadNotificationsByTypeHandler(c *fiber.Ctx, db *gorm.DB) error {
+	userID := c.Params("user_id")
+
+	var rows []struct {
+		Type  models.NotificationType
+		Total int64
+	}
+	if err := db.Model(&models.Notification{}).
+		Select("type, COUNT(*) AS total").
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Group("type").
+		Scan(&rows).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data notifications",
+			"error":   err.Error(),
+		})
+	}
+
+	// Semua type selalu dikembalikan agar badge tanpa notifikasi bernilai 0
+	counts := fiber.Map{
+		string(models.NotificationTypeInfo):    int64(0),
+		string(models.NotificationTypeSuccess): int64(0),
+		string(models.NotificationTypeWarning): int64(0),
+		string(models.NotificationTypeError):   int64(0),
+	}
+	var total int64
+	for _, row := range rows {
+		counts[string(row.Type)] = row.Total
+		total += row.Total
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    counts,
+		"total":   total,
+	})
+}
+
+// GetNotificationPreferencesHandler mendapatkan notification preferences user yang sedang login
+// @Summary      Get my notification preferences
+// @Description  Get notification type preferences of the authenticated user. Everything is enabled by default
+// @Tags         Notifications
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /notifications/preferences [get]
+func GetNotificationPreferencesHandler(c *fiber.Ctx, db *gorm.DB) error {
+	userID := c.Locals("user_id").(uint)
+
+	preference, err := findNotificationPreference(db, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil notification preferences",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    preference,
+	})
+}
+
+// UpdateNotificationPreferencesHandler mengupdate notification preferences user yang sedang login
+// @Summary      Update my notification preferences
+// @Description  Enable or disable notification types (info, success, warning, error) for the authenticated user
+// @Tags         Notifications
+// @Accept       json
+// @Produce      json
+// @Param        request  body      UpdateNotificationPreferenceRequest  true  "Update Notification Preference Request"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /notifications/preferences [put]
+func UpdateNotificationPreferencesHandler(c *fiber.Ctx, db *gorm.DB) error {
+	userID := c.Locals("user_id").(uint)
+
+	var req UpdateNotificationPreferenceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal parse request body",
+			"error":   err.Error(),
+		})
+	}
+
+	preference, err := findNotificationPreference(db, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil notification preferences",
+			"error":   err.Error(),
+		})
+	}
+
+	// Update fields jika ada
+	if req.InfoEnabled != nil {
+		preference.InfoEnabled = req.InfoEnabled
+	}
+	if req.SuccessEnabled != nil {
+		preference.SuccessEnabled = req.SuccessEnabled
+	}
+	if req.WarningEnabled != nil {
+		preference.WarningEnabled = req.WarningEnabled
+	}
+	if req.ErrorEnabled != nil {
+		preference.ErrorEnabled = req.ErrorEnabled
+	}
+
+	if err := db.Save(&preference).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate notification preferences",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Notification preferences berhasil diupdate",
+		"data":    preference,
+	})
+}
+
 // DeleteNotificationHandler menghapus notification (soft delete)
 // @Summary      Delete notification
 // @Description  Soft delete a notification by ID
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testNotification membuat notification minimal yang valid untuk di-seed
+func testNotification(userID int, notificationType models.NotificationType, isRead bool) models.Notification {
+	return models.Notification{
+		UserID:  userID,
+		Title:   string(notificationType),
+		Message: "message",
+		Date:    time.Now(),
+		IsRead:  boolPtr(isRead),
+		Type:    notificationType,
+	}
+}
+
+func TestGetUnreadNotificationsByTypeHandlerGroupsCounts(t *testing.T) {
+	db := testdb.New(t)
+	mustCreate(t, db, &[]models.Notification{
+		testNotification(1, models.NotificationTypeInfo, false),
+		testNotification(1, models.NotificationTypeInfo, false),
+		testNotification(1, models.NotificationTypeWarning, false),
+		testNotification(1, models.NotificationTypeError, false),
+		// Sudah dibaca dan milik user lain tidak dihitung
+		testNotification(1, models.NotificationTypeSuccess, true),
+		testNotification(2, models.NotificationTypeInfo, false),
+	})
+
+	app := newTestApp(db, "GET", "/notifications/user/:user_id/unread-by-type", 1, "user", GetUnreadNotificationsByTypeHandler)
+	status, body := doRequest(t, app, "GET", "/notifications/user/1/unread-by-type", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+
+	want := map[string]float64{"info": 2, "success": 0, "warning": 1, "error": 1}
+	counts := dataMap(t, body)
+	for notificationType, count := range want {
+		if counts[notificationType] != count {
+			t.Errorf("%s = %v, want %v", notificationType, counts[notificationType], count)
+		}
+	}
+	if body["total"] != float64(4) {
+		t.Errorf("total = %v, want 4", body["total"])
+	}
+}
+
+func TestCreateNotificationHandlerSkipsDisabledType(t *testing.T) {
+	db := testdb.New(t)
+
+	preferences := newTestApp(db, "PUT", "/notifications/preferences", 2, "user", UpdateNotificationPreferencesHandler)
+	status, body := doRequest(t, preferences, "PUT", "/notifications/preferences", map[string]interface{}{
+		"info_enabled": false,
+	})
+	if status != 200 {
+		t.Fatalf("preferences status = %d, body = %v", status, body)
+	}
+
+	app := newTestApp(db, "POST", "/notifications", 1, "admin", CreateNotificationHandler)
+	notification := func(userID int, notificationType string) map[string]interface{} {
+		return map[string]interface{}{
+			"user_id": userID,
+			"title":   "Hello",
+			"message": "message",
+			"date":    "2026-01-02",
+			"type":    notificationType,
+		}
+	}
+
+	status, body = doRequest(t, app, "POST", "/notifications", notification(2, "info"))
+	if status != 200 || body["skipped"] != true {
+		t.Errorf("disabled info status = %d, body = %v, want 200 skipped", status, body)
+	}
+	if status, body := doRequest(t, app, "POST", "/notifications", notification(2, "warning")); status != 201 {
+		t.Errorf("enabled warning status = %d, body = %v, want 201", status, body)
+	}
+	if status, body := doRequest(t, app, "POST", "/notifications", notification(3, "info")); status != 201 {
+		t.Errorf("user without preferences status = %d, body = %v, want 201", status, body)
+	}
+
+	var infoCount int64
+	if err := db.Model(&models.Notification{}).Where("user_id = ? AND type = ?", 2, models.NotificationTypeInfo).Count(&infoCount).Error; err != nil {
+		t.Fatal(err)
+	}
+	if infoCount != 0 {
+		t.Errorf("info notifications for user 2 = %d, want 0", infoCount)
+	}
+}
+
+func TestDeleteUserNotificationsHandlerDeletesOnlyMatchingType(t *testing.T) {
+	db := testdb.New(t)
+	mustCreate(t, db, &[]models.Notification{
+		testNotification(1, models.NotificationTypeInfo, false),
+		testNotification(1, models.NotificationTypeInfo, true),
+		testNotification(1, models.NotificationTypeWarning, false),
+		testNotification(2, models.NotificationTypeInfo, false),
+	})
+
+	// User lain tidak boleh menghapus notifications user 1
+	stranger := newTestApp(db, "DELETE", "/notifications/user/:user_id", 2, "user", DeleteUserNotificationsHandler)
+	if status, _ := doRequest(t, stranger, "DELETE", "/notifications/user/1?type=info", nil); status != 403 {
+		t.Errorf("stranger status = %d, want 403", status)
+	}
+
+	app := newTestApp(db, "DELETE", "/notifications/user/:user_id", 1, "user", DeleteUserNotificationsHandler)
+	if status, _ := doRequest(t, app, "DELETE", "/notifications/user/1?type=unknown", nil); status != 400 {
+		t.Errorf("invalid type status = %d, want 400", status)
+	}
+
+	status, body := doRequest(t, app, "DELETE", "/notifications/user/1?type=info", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if body["count"] != float64(2) {
+		t.Errorf("count = %v, want 2", body["count"])
+	}
+
+	remaining := map[string]int64{}
+	for _, check := range []struct {
+		key              string
+		userID           int
+		notificationType models.NotificationType
+	}{
+		{"user 1 info", 1, models.NotificationTypeInfo},
+		{"user 1 warning", 1, models.NotificationTypeWarning},
+		{"user 2 info", 2, models.NotificationTypeInfo},
+	} {
+		var count int64
+		db.Model(&models.Notification{}).Where("user_id = ? AND type = ?", check.userID, check.notificationType).Count(&count)
+		remaining[check.key] = count
+	}
+	want := map[string]int64{"user 1 info": 0, "user 1 warning": 1, "user 2 info": 1}
+	for key, count := range want {
+		if remaining[key] != count {
+			t.Errorf("%s = %d, want %d", key, remaining[key], count)
+		}
+	}
+
+	// Soft delete: data masih ada jika query Unscoped
+	var deleted int64
+	db.Unscoped().Model(&models.Notification{}).Where("user_id = ? AND type = ?", 1, models.NotificationTypeInfo).Count(&deleted)
+	if deleted != 2 {
+		t.Errorf("soft deleted info notifications = %d, want 2", deleted)
+	}
+}
+
+func TestGetUserNotificationsHandlerSyncsSinceID(t *testing.T) {
+	db := testdb.New(t)
+	var seeded []models.Notification
+	for i := 0; i < 5; i++ {
+		seeded = append(seeded, testNotification(1, models.NotificationTypeInfo, false))
+	}
+	seeded = append(seeded, testNotification(2, models.NotificationTypeInfo, false))
+	mustCreate(t, db, &seeded)
+
+	app := newTestApp(db, "GET", "/notifications/user/:user_id", 1, "user", GetUserNotificationsHandler)
+	sync := func(sinceID uint, query string) ([]uint, uint, map[string]interface{}) {
+		t.Helper()
+		status, body := doRequest(t, app, "GET", fmt.Sprintf("/notifications/user/1?since_id=%d%s", sinceID, query), nil)
+		if status != 200 {
+			t.Fatalf("status = %d, body = %v", status, body)
+		}
+		var ids []uint
+		for _, notification := range dataList(t, body) {
+			ids = append(ids, uint(notification["id"].(float64)))
+		}
+		return ids, uint(body["max_id"].(float64)), body["pagination"].(map[string]interface{})
+	}
+
+	// Batch pertama: page diabaikan, hanya limit yang dipakai
+	ids, maxID, pagination := sync(seeded[0].ID, "&limit=2&page=3")
+	if want := []uint{seeded[1].ID, seeded[2].ID}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("first batch ids = %v, want %v", ids, want)
+	}
+	if maxID != seeded[2].ID || pagination["has_more"] != true {
+		t.Errorf("first batch max_id = %d, pagination = %v, want max_id %d with more", maxID, pagination, seeded[2].ID)
+	}
+
+	// Batch berikutnya memakai max_id sebagai since_id sampai habis
+	ids, maxID, pagination = sync(maxID, "&limit=2&page=3")
+	if want := []uint{seeded[3].ID, seeded[4].ID}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("second batch ids = %v, want %v", ids, want)
+	}
+	if maxID != seeded[4].ID || pagination["has_more"] != false {
+		t.Errorf("second batch max_id = %d, pagination = %v, want max_id %d without more", maxID, pagination, seeded[4].ID)
+	}
+
+	// Tidak ada notification baru: max_id tetap since_id
+	ids, maxID, _ = sync(maxID, "")
+	if len(ids) != 0 || maxID != seeded[4].ID {
+		t.Errorf("empty sync ids = %v, max_id = %d, want none and %d", ids, maxID, seeded[4].ID)
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"backend_soundcave/config"
+	"backend_soundcave/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// paginateQuery menjalankan query list sesuai ?page dan ?limit (dibatasi PAGINATION_MAX_LIMIT).
+// Dengan ?skip_total=true, COUNT(*) dilewati dan has_more ditentukan dengan mengambil limit+1 baris.
+func paginateQuery[T any](c *fiber.Ctx, query *gorm.DB, dest *[]T) (fiber.Map, error) {
+	page, limit := utils.NormalizePagination(c.QueryInt("page", 1), c.QueryInt("limit", 10), config.GetPaginationMaxLimit())
+	offset := (page - 1) * limit
+
+	if c.QueryBool("skip_total", false) {
+		if err := query.Offset(offset).Limit(limit + 1).Find(dest).Error; err != nil {
+			return nil, err
+		}
+
+		hasMore := len(*dest) > limit
+		if hasMore {
+			*dest = (*dest)[:limit]
+		}
+
+		return fiber.Map{
+			"page":     page,
+			"limit":    limit,
+			"has_more": hasMore,
+		}, nil
+	}
+
+	// Get total count
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	if err := query.Offset(offset).Limit(limit).Find(dest).Error; err != nil {
+		return nil, err
+	}
+
+	return fiber.Map{
+		"page":     page,
+		"limit":    limit,
+		"total":    total,
+		"pages":    (int(total) + limit - 1) / limit,
+		"has_more": int64(offset+len(*dest)) < total,
+	}, nil
+}
+
+// limitQuery mengambil maksimal limit data tanpa offset (untuk sync berbasis cursor seperti since_id).
+// Query page diabaikan; has_more menandakan masih ada data setelah batch ini.
+func limitQuery[T any](c *fiber.Ctx, query *gorm.DB, dest *[]T) (fiber.Map, error) {
+	_, limit := utils.NormalizePagination(1, c.QueryInt("limit", 10), config.GetPaginationMaxLimit())
+
+	if err := query.Limit(limit + 1).Find(dest).Error; err != nil {
+		return nil, err
+	}
+
+	hasMore := len(*dest) > limit
+	if hasMore {
+		*dest = (*dest)[:limit]
+	}
+
+	return fiber.Map{
+		"limit":    limit,
+		"has_more": hasMore,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"testing"
+)
+
+func TestPaginateQuerySkipTotalReturnsHasMore(t *testing.T) {
+	db := testdb.New(t)
+	mustCreate(t, db, &[]models.Music{testMusic("One", 1), testMusic("Two", 1), testMusic("Three", 1)})
+
+	app := newTestApp(db, "GET", "/musics", 1, "user", GetMusicsHandler)
+
+	tests := []struct {
+		name      string
+		query     string
+		wantCount int
+		wantMore  bool
+	}{
+		{name: "first page has more", query: "?skip_total=true&limit=2&page=1", wantCount: 2, wantMore: true},
+		{name: "last page has no more", query: "?skip_total=true&limit=2&page=2", wantCount: 1, wantMore: false},
+		{name: "exact fit has no more", query: "?skip_total=true&limit=3&page=1", wantCount: 3, wantMore: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, app, "GET", "/musics"+tt.query, nil)
+			if status != 200 {
+				t.Fatalf("status = %d, body = %v", status, body)
+			}
+			if got := len(dataList(t, body)); got != tt.wantCount {
+				t.Errorf("got %d musics, want %d", got, tt.wantCount)
+			}
+
+			pagination := body["pagination"].(map[string]interface{})
+			if pagination["has_more"] != tt.wantMore {
+				t.Errorf("has_more = %v, want %v", pagination["has_more"], tt.wantMore)
+			}
+			if _, ok := pagination["total"]; ok {
+				t.Errorf("pagination = %v, want no total when skip_total=true", pagination)
+			}
+		})
+	}
+
+	// Tanpa skip_total, total tetap dihitung
+	status, body := doRequest(t, app, "GET", "/musics?limit=2", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	pagination := body["pagination"].(map[string]interface{})
+	if pagination["total"] != float64(3) || pagination["has_more"] != true {
+		t.Errorf("pagination = %v, want total 3 and has_more true", pagination)
+	}
+}
+
+func TestPaginateQueryCapsLimit(t *testing.T) {
+	db := testdb.New(t)
+	t.Setenv("PAGINATION_MAX_LIMIT", "2")
+	mustCreate(t, db, &[]models.Music{testMusic("One", 1), testMusic("Two", 1), testMusic("Three", 1)})
+
+	app := newTestApp(db, "GET", "/musics", 1, "user", GetMusicsHandler)
+	status, body := doRequest(t, app, "GET", "/musics?limit=500", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got := len(dataList(t, body)); got != 2 {
+		t.Errorf("got %d musics, want 2 (capped)", got)
+	}
+	if limit := body["pagination"].(map[string]interface{})["limit"]; limit != float64(2) {
+		t.Errorf("limit = %v, want 2", limit)
+	}
+}
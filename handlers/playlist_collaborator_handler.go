@@ -0,0 +1,290 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// AddPlaylistCollaboratorRequest struct untuk request menambahkan collaborator playlist
+type AddPlaylistCollaboratorRequest struct {
+	UserID uint `json:"user_id" validate:"required"`
+}
+
+// isPlaylistOwner mengecek apakah user yang login adalah pemilik playlist atau admin
+func isPlaylistOwner(c *fiber.Ctx, playlist models.Playlist) bool {
+	userID, _ := c.Locals("user_id").(uint)
+	role, _ := c.Locals("role").(string)
+	return role == "admin" || (userID != 0 && playlist.UserID == userID)
+}
+
+// canManagePlaylist mengecek apakah user yang login boleh mengubah isi playlist:
+// pemilik, admin, atau user yang terdaftar sebagai collaborator
+func canManagePlaylist(c *fiber.Ctx, db *gorm.DB, playlist models.Playlist) (bool, error) {
+	if isPlaylistOwner(c, playlist) {
+		return true, nil
+	}
+
+	userID, _ := c.Locals("user_id").(uint)
+	if userID == 0 {
+		return false, nil
+	}
+
+	var count int64
+	if err := db.Model(&models.PlaylistCollaborator{}).
+		Where("playlist_id = ? AND user_id = ?", playlist.ID, userID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// canManagePlaylistID sama dengan canManagePlaylist namun mengambil playlist berdasarkan ID terlebih dahulu.
+// Mengembalikan gorm.ErrRecordNotFound jika playlist tidak ditemukan.
+func canManagePlaylistID(c *fiber.Ctx, db *gorm.DB, playlistID uint) (bool, error) {
+	var playlist models.Playlist
+	if err := db.Select("id", "user_id").First(&playlist, playlistID).Error; err != nil {
+		return false, err
+	}
+	return canManagePlaylist(c, db, playlist)
+}
+
+// GetPlaylistCollaboratorsHandler mendapatkan daftar collaborator playlist
+// @Summary      Get playlist collaborators
+// @Description  Get all collaborators of a playlist (owner, collaborators, or admin)
+// @Tags         Playlists
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Playlist ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /playlists/{id}/collaborators [get]
+func GetPlaylistCollaboratorsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	var playlist models.Playlist
+	if err := db.First(&playlist, c.Params("id")).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Playlist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlist",
+			"error":   err.Error(),
+		})
+	}
+
+	allowed, err := canManagePlaylist(c, db, playlist)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengecek akses playlist",
+			"error":   err.Error(),
+		})
+	}
+	if !allowed {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Anda tidak memiliki akses ke playlist ini",
+		})
+	}
+
+	var collaborators []models.PlaylistCollaborator
+	if err := db.Where("playlist_id = ?", playlist.ID).
+		Preload("User", func(tx *gorm.DB) *gorm.DB {
+			return tx.Select("id", "full_name", "profile_image")
+		}).
+		Order("created_at ASC").
+		Find(&collaborators).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data collaborator",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    collaborators,
+	})
+}
+
+// AddPlaylistCollaboratorHandler menambahkan collaborator ke playlist
+// @Summary      Add playlist collaborator
+// @Description  Add a user as collaborator of a playlist. Collaborators can edit the playlist and its songs (owner or admin only)
+// @Tags         Playlists
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                             true  "Playlist ID"
+// @Param        request  body      AddPlaylistCollaboratorRequest  true  "Add Collaborator Request"
+// @Success      201      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      409      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /playlists/{id}/collaborators [post]
+func AddPlaylistCollaboratorHandler(c *fiber.Ctx, db *gorm.DB) error {
+	var req AddPlaylistCollaboratorRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal parse request body",
+			"error":   err.Error(),
+		})
+	}
+	if req.UserID == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "user_id wajib diisi",
+		})
+	}
+
+	var playlist models.Playlist
+	if err := db.First(&playlist, c.Params("id")).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Playlist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Hanya pemilik playlist (atau admin) yang boleh menambahkan collaborator
+	if !isPlaylistOwner(c, playlist) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Hanya pemilik playlist yang dapat menambahkan collaborator",
+		})
+	}
+	if req.UserID == playlist.UserID {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Pemilik playlist tidak dapat menjadi collaborator",
+		})
+	}
+
+	var user models.User
+	if err := db.Select("id").First(&user, req.UserID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "User tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data user",
+			"error":   err.Error(),
+		})
+	}
+
+	var existing int64
+	db.Model(&models.PlaylistCollaborator{}).Where("playlist_id = ? AND user_id = ?", playlist.ID, req.UserID).Count(&existing)
+	if existing > 0 {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"success": false,
+			"message": "User sudah menjadi collaborator playlist ini",
+		})
+	}
+
+	collaborator := models.PlaylistCollaborator{
+		PlaylistID: playlist.ID,
+		UserID:     req.UserID,
+	}
+	if err := db.Create(&collaborator).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menambahkan collaborator",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Collaborator berhasil ditambahkan",
+		"data":    collaborator,
+	})
+}
+
+// RemovePlaylistCollaboratorHandler menghapus collaborator dari playlist
+// @Summary      Remove playlist collaborator
+// @Description  Remove a collaborator from a playlist (owner or admin). A collaborator can also remove themselves to leave the playlist
+// @Tags         Playlists
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Playlist ID"
+// @Param        user_id  path      int  true  "Collaborator User ID"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /playlists/{id}/collaborators/{user_id} [delete]
+func RemovePlaylistCollaboratorHandler(c *fiber.Ctx, db *gorm.DB) error {
+	collaboratorUserID, err := c.ParamsInt("user_id")
+	if err != nil || collaboratorUserID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "user_id tidak valid",
+		})
+	}
+
+	var playlist models.Playlist
+	if err := db.First(&playlist, c.Params("id")).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Playlist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Pemilik/admin boleh menghapus siapa saja, collaborator hanya dirinya sendiri
+	userID, _ := c.Locals("user_id").(uint)
+	if !isPlaylistOwner(c, playlist) && uint(collaboratorUserID) != userID {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Hanya pemilik playlist yang dapat menghapus collaborator",
+		})
+	}
+
+	result := db.Where("playlist_id = ? AND user_id = ?", playlist.ID, collaboratorUserID).Delete(&models.PlaylistCollaborator{})
+	if result.Error != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghapus collaborator",
+			"error":   result.Error.Error(),
+		})
+	}
+	if result.RowsAffected == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"success": false,
+			"message": "Collaborator tidak ditemukan",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Collaborator berhasil dihapus",
+	})
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
@@ -24,6 +25,11 @@ type UpdatePlaylistRequest struct {
 	CoverImage  *string `json:"cover_image"`
 }
 
+// TransferPlaylistRequest struct untuk request transfer kepemilikan playlist
+type TransferPlaylistRequest struct {
+	UserID uint `json:"user_id" validate:"required"`
+}
+
 // CreatePlaylistHandler membuat playlist baru
 // @Summary      Create new playlist
 // @Description  Create a new playlist
@@ -98,6 +104,7 @@ func CreatePlaylistHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        user_id  query     int     false  "Filter by user ID"
 // @Param        search   query     string  false  "Search by name"
 // @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -133,9 +140,12 @@ func GetPlaylistsHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	// Sort by name atau created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
@@ -164,6 +174,80 @@ func GetPlaylistsHandler(c *fiber.Ctx, db *gorm.DB) error {
 	})
 }
 
+// GetMyPlaylistsHandler mendapatkan playlist milik user atau yang dibagikan ke user
+// @Summary      Get my playlists
+// @Description  Get paginated playlists the authenticated user owns or collaborates on
+// @Tags         Playlists
+// @Accept       json
+// @Produce      json
+// @Param        role   query     string  false  "Filter by relation (owner, collaborator). Empty returns both"
+// @Param        page   query     int     false  "Page number" default(1)
+// @Param        limit  query     int     false  "Items per page" default(10)
+// @Success      200    {object}  map[string]interface{}
+// @Failure      400    {object}  map[string]interface{}
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /playlists/me [get]
+func GetMyPlaylistsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	userID, ok := c.Locals("user_id").(uint)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "User ID tidak valid",
+		})
+	}
+
+	var playlists []models.Playlist
+
+	// Pagination
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 10)
+	offset := (page - 1) * limit
+
+	query := db.Model(&models.Playlist{})
+
+	switch c.Query("role") {
+	case "owner":
+		query = query.Where("playlists.user_id = ?", userID)
+	case "collaborator":
+		query = query.Joins("JOIN playlist_collaborators ON playlist_collaborators.playlist_id = playlists.id").
+			Where("playlist_collaborators.user_id = ?", userID)
+	case "":
+		query = query.Joins("LEFT JOIN playlist_collaborators ON playlist_collaborators.playlist_id = playlists.id AND playlist_collaborators.user_id = ?", userID).
+			Where("playlists.user_id = ? OR playlist_collaborators.id IS NOT NULL", userID)
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "role tidak valid. Pilih: owner atau collaborator",
+		})
+	}
+
+	// Get total count
+	var total int64
+	query.Count(&total)
+
+	// Get playlists
+	if err := query.Order("playlists.created_at DESC").Offset(offset).Limit(limit).Find(&playlists).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlists",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    playlists,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+			"pages": (int(total) + limit - 1) / limit,
+		},
+	})
+}
+
 // GetPlaylistHandler mendapatkan playlist by ID
 // @Summary      Get playlist by ID
 // @Description  Get playlist details by ID
@@ -203,7 +287,7 @@ func GetPlaylistHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 // UpdatePlaylistHandler mengupdate playlist
 // @Summary      Update playlist
-// @Description  Update playlist information
+// @Description  Update playlist information (owner, collaborators, or admin)
 // @Tags         Playlists
 // @Accept       json
 // @Produce      json
@@ -212,6 +296,7 @@ func GetPlaylistHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Success      200      {object}  map[string]interface{}
 // @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
 // @Failure      404      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -234,6 +319,22 @@ func UpdatePlaylistHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// Pemilik, admin, dan collaborator boleh mengubah playlist
+	allowed, err := canManagePlaylist(c, db, playlist)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengecek akses playlist",
+			"error":   err.Error(),
+		})
+	}
+	if !allowed {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Anda tidak memiliki akses untuk mengubah playlist ini",
+		})
+	}
+
 	var req UpdatePlaylistRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -277,13 +378,14 @@ func UpdatePlaylistHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 // DeletePlaylistHandler menghapus playlist (soft delete)
 // @Summary      Delete playlist
-// @Description  Soft delete a playlist by ID
+// @Description  Soft delete a playlist by ID (owner or admin)
 // @Tags         Playlists
 // @Accept       json
 // @Produce      json
 // @Param        id   path      int  true  "Playlist ID"
 // @Success      200  {object}  map[string]interface{}
 // @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -306,6 +408,14 @@ func DeletePlaylistHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// Hanya pemilik (atau admin) yang boleh menghapus playlist, collaborator tidak
+	if !isPlaylistOwner(c, playlist) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Hanya pemilik playlist yang dapat menghapus playlist",
+		})
+	}
+
 	if err := db.Delete(&playlist).Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -319,3 +429,218 @@ func DeletePlaylistHandler(c *fiber.Ctx, db *gorm.DB) error {
 		"message": "Playlist berhasil dihapus",
 	})
 }
+
+// TransferPlaylistHandler memindahkan kepemilikan playlist ke collaborator
+// @Summary      Transfer playlist ownership
+// @Description  Transfer playlist ownership to one of its collaborators. The previous owner becomes a collaborator (owner only)
+// @Tags         Playlists
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                      true  "Playlist ID"
+// @Param        request  body      TransferPlaylistRequest  true  "Transfer Playlist Request"
+// @Success      200      {object}  map[string]interface{}
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /playlists/{id}/transfer [post]
+func TransferPlaylistHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+
+	userID, ok := c.Locals("user_id").(uint)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "User ID tidak valid",
+		})
+	}
+
+	var req TransferPlaylistRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal parse request body",
+			"error":   err.Error(),
+		})
+	}
+	if req.UserID == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "user_id wajib diisi",
+		})
+	}
+
+	var playlist models.Playlist
+	if err := db.First(&playlist, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Playlist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Hanya pemilik playlist yang boleh mentransfer kepemilikan
+	if playlist.UserID != userID {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Hanya pemilik playlist yang dapat mentransfer kepemilikan",
+		})
+	}
+	if req.UserID == playlist.UserID {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "User tujuan sudah menjadi pemilik playlist",
+		})
+	}
+
+	// Mulai transaksi
+	tx := db.Begin()
+
+	var collaborator models.PlaylistCollaborator
+	if err := tx.Where("playlist_id = ? AND user_id = ?", playlist.ID, req.UserID).First(&collaborator).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"message": "User tujuan harus merupakan collaborator playlist",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data collaborator",
+			"error":   err.Error(),
+		})
+	}
+
+	// Pemilik baru tidak lagi tercatat sebagai collaborator
+	if err := tx.Delete(&collaborator).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate collaborator",
+			"error":   err.Error(),
+		})
+	}
+
+	// Pemilik lama menjadi collaborator sehingga tetap bisa mengubah playlist dan lagunya (lihat canManagePlaylist)
+	previousOwner := models.PlaylistCollaborator{
+		PlaylistID: playlist.ID,
+		UserID:     playlist.UserID,
+	}
+	if err := tx.Create(&previousOwner).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate collaborator",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Model(&playlist).Update("user_id", req.UserID).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mentransfer playlist",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mentransfer playlist",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Kepemilikan playlist berhasil ditransfer",
+		"data":    playlist,
+	})
+}
+
+// DeleteMyPlaylistsHandler menghapus semua playlist milik user yang sedang login (soft delete)
+// @Summary      Delete all my playlists
+// @Description  Soft delete all playlists owned by the authenticated user together with their songs
+// @Tags         Playlists
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /profile/playlists [delete]
+func DeleteMyPlaylistsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	userID, ok := c.Locals("user_id").(uint)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "User ID tidak valid",
+		})
+	}
+
+	// Mulai transaksi
+	tx := db.Begin()
+
+	var playlistIDs []uint
+	if err := tx.Model(&models.Playlist{}).Where("user_id = ?", userID).Pluck("id", &playlistIDs).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlists",
+			"error":   err.Error(),
+		})
+	}
+
+	if len(playlistIDs) == 0 {
+		tx.Rollback()
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{
+			"success": true,
+			"message": "Tidak ada playlist untuk dihapus",
+			"count":   0,
+		})
+	}
+
+	// Hapus semua lagu di dalam playlist tersebut
+	if err := tx.Where("playlist_id IN ?", playlistIDs).Delete(&models.PlaylistSong{}).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghapus lagu dari playlist",
+			"error":   err.Error(),
+		})
+	}
+
+	result := tx.Where("id IN ?", playlistIDs).Delete(&models.Playlist{})
+	if result.Error != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghapus playlists",
+			"error":   result.Error.Error(),
+		})
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menghapus playlists",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Semua playlist berhasil dihapus",
+		"count":   result.RowsAffected,
+	})
+}
@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"testing"
+)
+
+func TestDeleteMyPlaylistsOnlyDeletesCallerPlaylists(t *testing.T) {
+	db := testdb.New(t)
+
+	mine := []models.Playlist{{UserID: 1, Name: "Mine A"}, {UserID: 1, Name: "Mine B"}}
+	other := models.Playlist{UserID: 2, Name: "Other"}
+	mustCreate(t, db, &mine)
+	mustCreate(t, db, &other)
+	mustCreate(t, db, &[]models.PlaylistSong{
+		{PlaylistID: mine[0].ID, MusicID: 10},
+		{PlaylistID: mine[1].ID, MusicID: 11},
+		{PlaylistID: other.ID, MusicID: 10},
+	})
+
+	app := newTestApp(db, "DELETE", "/profile/playlists", 1, "user", DeleteMyPlaylistsHandler)
+	status, body := doRequest(t, app, "DELETE", "/profile/playlists", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if body["count"] != float64(2) {
+		t.Errorf("count = %v, want 2", body["count"])
+	}
+
+	var remaining []models.Playlist
+	db.Find(&remaining)
+	if len(remaining) != 1 || remaining[0].ID != other.ID {
+		t.Errorf("remaining playlists = %+v, want only playlist %d", remaining, other.ID)
+	}
+
+	var songs []models.PlaylistSong
+	db.Find(&songs)
+	if len(songs) != 1 || songs[0].PlaylistID != other.ID {
+		t.Errorf("remaining songs = %+v, want only songs of playlist %d", songs, other.ID)
+	}
+
+	// Soft delete: baris milik caller masih ada secara Unscoped
+	var total int64
+	db.Unscoped().Model(&models.Playlist{}).Count(&total)
+	if total != 3 {
+		t.Errorf("unscoped playlists = %d, want 3", total)
+	}
+}
+
+func TestTransferPlaylistHandlerRequiresCollaborator(t *testing.T) {
+	db := testdb.New(t)
+	users := seedUsers(t, db, 3)
+	owner, collaborator, stranger := users[0], users[1], users[2]
+
+	playlist := models.Playlist{UserID: owner.ID, Name: "Road Trip"}
+	mustCreate(t, db, &playlist)
+
+	transferApp := newTestApp(db, "POST", "/playlists/:id/transfer", owner.ID, "user", TransferPlaylistHandler)
+	target := fmt.Sprintf("/playlists/%d/transfer", playlist.ID)
+
+	// User yang bukan collaborator tidak bisa menerima playlist
+	status, body := doRequest(t, transferApp, "POST", target, map[string]interface{}{"user_id": stranger.ID})
+	if status != 400 {
+		t.Fatalf("transfer to non-collaborator status = %d, want 400 (body = %v)", status, body)
+	}
+
+	addApp := newTestApp(db, "POST", "/playlists/:id/collaborators", owner.ID, "user", AddPlaylistCollaboratorHandler)
+	status, body = doRequest(t, addApp, "POST", fmt.Sprintf("/playlists/%d/collaborators", playlist.ID), map[string]interface{}{"user_id": collaborator.ID})
+	if status != 201 {
+		t.Fatalf("add collaborator status = %d, body = %v", status, body)
+	}
+
+	// Bukan pemilik tidak bisa mentransfer
+	strangerApp := newTestApp(db, "POST", "/playlists/:id/transfer", stranger.ID, "user", TransferPlaylistHandler)
+	if status, _ := doRequest(t, strangerApp, "POST", target, map[string]interface{}{"user_id": collaborator.ID}); status != 403 {
+		t.Errorf("transfer by non-owner status = %d, want 403", status)
+	}
+
+	status, body = doRequest(t, transferApp, "POST", target, map[string]interface{}{"user_id": collaborator.ID})
+	if status != 200 {
+		t.Fatalf("transfer status = %d, body = %v", status, body)
+	}
+
+	var updated models.Playlist
+	db.First(&updated, playlist.ID)
+	if updated.UserID != collaborator.ID {
+		t.Errorf("owner = %d, want %d", updated.UserID, collaborator.ID)
+	}
+
+	var collaborators []models.PlaylistCollaborator
+	db.Where("playlist_id = ?", playlist.ID).Find(&collaborators)
+	if len(collaborators) != 1 || collaborators[0].UserID != owner.ID {
+		t.Errorf("collaborators = %+v, want only the previous owner %d", collaborators, owner.ID)
+	}
+}
+
+func TestPlaylistCollaboratorPermissions(t *testing.T) {
+	db := testdb.New(t)
+	users := seedUsers(t, db, 3)
+	owner, collaborator, stranger := users[0], users[1], users[2]
+
+	playlist := models.Playlist{UserID: owner.ID, Name: "Shared"}
+	mustCreate(t, db, &playlist)
+	music := testMusic("Song", 1)
+	mustCreate(t, db, &music)
+
+	addPath := fmt.Sprintf("/playlists/%d/collaborators", playlist.ID)
+
+	// Hanya pemilik yang bisa menambahkan collaborator
+	strangerAdd := newTestApp(db, "POST", "/playlists/:id/collaborators", stranger.ID, "user", AddPlaylistCollaboratorHandler)
+	if status, _ := doRequest(t, strangerAdd, "POST", addPath, map[string]interface{}{"user_id": stranger.ID}); status != 403 {
+		t.Errorf("add by non-owner status = %d, want 403", status)
+	}
+	ownerAdd := newTestApp(db, "POST", "/playlists/:id/collaborators", owner.ID, "user", AddPlaylistCollaboratorHandler)
+	if status, body := doRequest(t, ownerAdd, "POST", addPath, map[string]interface{}{"user_id": collaborator.ID}); status != 201 {
+		t.Fatalf("add collaborator status = %d, body = %v", status, body)
+	}
+	if status, _ := doRequest(t, ownerAdd, "POST", addPath, map[string]interface{}{"user_id": collaborator.ID}); status != 409 {
+		t.Errorf("duplicate add status = %d, want 409", status)
+	}
+
+	listApp := newTestApp(db, "GET", "/playlists/:id/collaborators", collaborator.ID, "user", GetPlaylistCollaboratorsHandler)
+	status, body := doRequest(t, listApp, "GET", addPath, nil)
+	if status != 200 {
+		t.Fatalf("list collaborators status = %d, body = %v", status, body)
+	}
+	if list := dataList(t, body); len(list) != 1 || list[0]["user_id"] != float64(collaborator.ID) {
+		t.Errorf("collaborators = %v, want user %d", list, collaborator.ID)
+	}
+
+	playlistPath := fmt.Sprintf("/playlists/%d", playlist.ID)
+	newName := map[string]interface{}{"name": "Renamed"}
+	song := map[string]interface{}{"playlist_id": playlist.ID, "music_id": music.ID}
+
+	// Collaborator boleh mengubah playlist dan lagunya, tapi tidak boleh menghapus playlist
+	collaboratorUpdate := newTestApp(db, "PUT", "/playlists/:id", collaborator.ID, "user", UpdatePlaylistHandler)
+	if status, body := doRequest(t, collaboratorUpdate, "PUT", playlistPath, newName); status != 200 {
+		t.Errorf("collaborator update status = %d, body = %v", status, body)
+	}
+	collaboratorSong := newTestApp(db, "POST", "/playlist-songs", collaborator.ID, "user", CreatePlaylistSongHandler)
+	if status, body := doRequest(t, collaboratorSong, "POST", "/playlist-songs", song); status != 201 {
+		t.Errorf("collaborator add song status = %d, body = %v", status, body)
+	}
+	collaboratorRemoveSong := newTestApp(db, "DELETE", "/playlist-songs/playlist/:playlist_id/music/:music_id", collaborator.ID, "user", DeletePlaylistSongByMusicHandler)
+	if status, body := doRequest(t, collaboratorRemoveSong, "DELETE", fmt.Sprintf("/playlist-songs/playlist/%d/music/%d", playlist.ID, music.ID), nil); status != 200 {
+		t.Errorf("collaborator remove song status = %d, body = %v", status, body)
+	}
+	collaboratorDelete := newTestApp(db, "DELETE", "/playlists/:id", collaborator.ID, "user", DeletePlaylistHandler)
+	if status, _ := doRequest(t, collaboratorDelete, "DELETE", playlistPath, nil); status != 403 {
+		t.Errorf("collaborator delete status = %d, want 403", status)
+	}
+
+	// User lain tidak punya akses sama sekali
+	strangerUpdate := newTestApp(db, "PUT", "/playlists/:id", stranger.ID, "user", UpdatePlaylistHandler)
+	if status, _ := doRequest(t, strangerUpdate, "PUT", playlistPath, newName); status != 403 {
+		t.Errorf("stranger update status = %d, want 403", status)
+	}
+	strangerSong := newTestApp(db, "POST", "/playlist-songs", stranger.ID, "user", CreatePlaylistSongHandler)
+	if status, _ := doRequest(t, strangerSong, "POST", "/playlist-songs", song); status != 403 {
+		t.Errorf("stranger add song status = %d, want 403", status)
+	}
+
+	// Collaborator bisa keluar sendiri, setelah itu kehilangan akses
+	leaveApp := newTestApp(db, "DELETE", "/playlists/:id/collaborators/:user_id", collaborator.ID, "user", RemovePlaylistCollaboratorHandler)
+	if status, body := doRequest(t, leaveApp, "DELETE", fmt.Sprintf("/playlists/%d/collaborators/%d", playlist.ID, collaborator.ID), nil); status != 200 {
+		t.Fatalf("leave status = %d, body = %v", status, body)
+	}
+	if status, _ := doRequest(t, collaboratorUpdate, "PUT", playlistPath, newName); status != 403 {
+		t.Errorf("update after leaving status = %d, want 403", status)
+	}
+}
+
+func TestGetMyPlaylistsHandlerFiltersByRole(t *testing.T) {
+	db := testdb.New(t)
+	users := seedUsers(t, db, 2)
+	me, friend := users[0], users[1]
+
+	owned := models.Playlist{UserID: me.ID, Name: "Owned"}
+	shared := models.Playlist{UserID: friend.ID, Name: "Shared"}
+	unrelated := models.Playlist{UserID: friend.ID, Name: "Unrelated"}
+	mustCreate(t, db, &owned)
+	mustCreate(t, db, &shared)
+	mustCreate(t, db, &unrelated)
+
+	// Teman menambahkan saya sebagai collaborator lewat endpoint
+	addApp := newTestApp(db, "POST", "/playlists/:id/collaborators", friend.ID, "user", AddPlaylistCollaboratorHandler)
+	if status, body := doRequest(t, addApp, "POST", fmt.Sprintf("/playlists/%d/collaborators", shared.ID), map[string]interface{}{"user_id": me.ID}); status != 201 {
+		t.Fatalf("add collaborator status = %d, body = %v", status, body)
+	}
+
+	app := newTestApp(db, "GET", "/playlists/me", me.ID, "user", GetMyPlaylistsHandler)
+	tests := []struct {
+		role string
+		want []string
+	}{
+		{role: "owner", want: []string{"Owned"}},
+		{role: "collaborator", want: []string{"Shared"}},
+		{role: "", want: []string{"Owned", "Shared"}},
+	}
+	for _, tt := range tests {
+		t.Run("role="+tt.role, func(t *testing.T) {
+			status, body := doRequest(t, app, "GET", "/playlists/me?role="+tt.role, nil)
+			if status != 200 {
+				t.Fatalf("status = %d, body = %v", status, body)
+			}
+			got := map[string]bool{}
+			for _, playlist := range dataList(t, body) {
+				got[playlist["name"].(string)] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("playlists = %v, want %v", got, tt.want)
+			}
+			for _, name := range tt.want {
+				if !got[name] {
+					t.Errorf("playlists = %v, missing %s", got, name)
+				}
+			}
+		})
+	}
+
+	if status, _ := doRequest(t, app, "GET", "/playlists/me?role=viewer", nil); status != 400 {
+		t.Errorf("invalid role status = %d, want 400", status)
+	}
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"backend_soundcave/models"
+	"sort"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// PlaylistIntegrityReport struct untuk hasil pengecekan posisi lagu dalam playlist
+type PlaylistIntegrityReport struct {
+	PlaylistID          uint  `json:"playlist_id"`
+	TotalSongs          int   `json:"total_songs"`
+	DuplicatePositions  []int `json:"duplicate_positions"`
+	MissingPositions    []int `json:"missing_positions"`
+	OutOfRangePositions []int `json:"out_of_range_positions"`
+	IsValid             bool  `json:"is_valid"`
+}
+
+// buildPlaylistIntegrityReport membandingkan posisi lagu dengan urutan yang diharapkan 0..n-1
+func buildPlaylistIntegrityReport(playlistID uint, songs []models.PlaylistSong) PlaylistIntegrityReport {
+	report := PlaylistIntegrityReport{
+		PlaylistID:          playlistID,
+		TotalSongs:          len(songs),
+		DuplicatePositions:  []int{},
+		MissingPositions:    []int{},
+		OutOfRangePositions: []int{},
+	}
+
+	counts := make(map[int]int)
+	for _, song := range songs {
+		counts[song.Position]++
+	}
+
+	for position, count := range counts {
+		if count > 1 {
+			report.DuplicatePositions = append(report.DuplicatePositions, position)
+		}
+		if position < 0 || position >= len(songs) {
+			report.OutOfRangePositions = append(report.OutOfRangePositions, position)
+		}
+	}
+	for position := 0; position < len(songs); position++ {
+		if counts[position] == 0 {
+			report.MissingPositions = append(report.MissingPositions, position)
+		}
+	}
+
+	sort.Ints(report.DuplicatePositions)
+	sort.Ints(report.OutOfRangePositions)
+
+	report.IsValid = len(report.DuplicatePositions) == 0 &&
+		len(report.MissingPositions) == 0 &&
+		len(report.OutOfRangePositions) == 0
+
+	return report
+}
+
+// GetPlaylistIntegrityHandler mengecek posisi lagu yang duplikat atau bolong dalam playlist
+// @Summary      Check playlist positions integrity
+// @Description  Report duplicate, missing and out of range song positions of a playlist. Positions are expected to be contiguous 0..n-1 (admin only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Playlist ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /admin/playlists/{id}/integrity [get]
+func GetPlaylistIntegrityHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+
+	var playlist models.Playlist
+	if err := db.First(&playlist, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Playlist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlist",
+			"error":   err.Error(),
+		})
+	}
+
+	var songs []models.PlaylistSong
+	if err := db.Where("playlist_id = ?", playlist.ID).Order("position ASC, id ASC").Find(&songs).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlist songs",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    buildPlaylistIntegrityReport(playlist.ID, songs),
+	})
+}
+
+// NormalizePlaylistPositionsHandler merapikan posisi lagu dalam playlist menjadi 0..n-1
+// @Summary      Normalize playlist positions
+// @Description  Rewrite song positions of a playlist to contiguous 0..n-1, keeping the current order (ties broken by ID) (admin only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Playlist ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /admin/playlists/{id}/integrity/normalize [post]
+func NormalizePlaylistPositionsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+
+	var playlist models.Playlist
+	if err := db.First(&playlist, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Playlist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlist",
+			"error":   err.Error(),
+		})
+	}
+
+	// Mulai transaksi
+	tx := db.Begin()
+
+	var songs []models.PlaylistSong
+	if err := tx.Where("playlist_id = ?", playlist.ID).Order("position ASC, id ASC").Find(&songs).Error; err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data playlist songs",
+			"error":   err.Error(),
+		})
+	}
+
+	updated := 0
+	for i := range songs {
+		if songs[i].Position == i {
+			continue
+		}
+		if err := tx.Model(&songs[i]).Update("position", i).Error; err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Gagal mengupdate posisi playlist songs",
+				"error":   err.Error(),
+			})
+		}
+		songs[i].Position = i
+		updated++
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengupdate posisi playlist songs",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Posisi playlist songs berhasil dirapikan",
+		"data":    buildPlaylistIntegrityReport(playlist.ID, songs),
+		"updated": updated,
+	})
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"testing"
+)
+
+func TestPlaylistIntegrityReportsAndNormalizesDuplicatePositions(t *testing.T) {
+	db := testdb.New(t)
+	playlist := models.Playlist{UserID: 1, Name: "Messy"}
+	mustCreate(t, db, &playlist)
+	mustCreate(t, db, &[]models.PlaylistSong{
+		{PlaylistID: playlist.ID, MusicID: 1, Position: 0},
+		{PlaylistID: playlist.ID, MusicID: 2, Position: 1},
+		{PlaylistID: playlist.ID, MusicID: 3, Position: 1},
+		{PlaylistID: playlist.ID, MusicID: 4, Position: 5},
+	})
+
+	path := fmt.Sprintf("/admin/playlists/%d/integrity", playlist.ID)
+	checkApp := newTestApp(db, "GET", "/admin/playlists/:id/integrity", 1, "admin", GetPlaylistIntegrityHandler)
+	normalizeApp := newTestApp(db, "POST", "/admin/playlists/:id/integrity/normalize", 1, "admin", NormalizePlaylistPositionsHandler)
+
+	status, body := doRequest(t, checkApp, "GET", path, nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	report := dataMap(t, body)
+	if report["is_valid"] != false {
+		t.Errorf("is_valid = %v, want false", report["is_valid"])
+	}
+	if fmt.Sprint(report["duplicate_positions"]) != "[1]" ||
+		fmt.Sprint(report["missing_positions"]) != "[2 3]" ||
+		fmt.Sprint(report["out_of_range_positions"]) != "[5]" {
+		t.Errorf("report = %v, want duplicate [1], missing [2 3], out of range [5]", report)
+	}
+
+	status, body = doRequest(t, normalizeApp, "POST", path+"/normalize", nil)
+	if status != 200 {
+		t.Fatalf("normalize status = %d, body = %v", status, body)
+	}
+	if report := dataMap(t, body); report["is_valid"] != true {
+		t.Errorf("report after normalize = %v, want valid", report)
+	}
+
+	// Urutan relatif tetap dipertahankan, posisi menjadi 0..n-1
+	var songs []models.PlaylistSong
+	db.Where("playlist_id = ?", playlist.ID).Order("position ASC").Find(&songs)
+	for i, song := range songs {
+		if song.Position != i || song.MusicID != uint(i+1) {
+			t.Errorf("songs[%d] = music %d at %d, want music %d at %d", i, song.MusicID, song.Position, i+1, i)
+		}
+	}
+
+	status, body = doRequest(t, checkApp, "GET", path, nil)
+	if status != 200 || dataMap(t, body)["is_valid"] != true {
+		t.Errorf("integrity after normalize = %d %v, want valid", status, body)
+	}
+}
@@ -29,6 +29,7 @@ type UpdatePlaylistSongRequest struct {
 // @Success      201      {object}  map[string]interface{}
 // @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /playlist-songs [post]
@@ -59,6 +60,22 @@ func CreatePlaylistSongHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// Hanya pemilik, admin, dan collaborator playlist yang boleh menambahkan lagu
+	allowed, err := canManagePlaylist(c, db, playlist)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengecek akses playlist",
+			"error":   err.Error(),
+		})
+	}
+	if !allowed {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Anda tidak memiliki akses untuk mengubah playlist ini",
+		})
+	}
+
 	// Validasi music exists
 	var music models.Music
 	if err := db.First(&music, req.MusicID).Error; err != nil {
@@ -228,6 +245,7 @@ func GetPlaylistSongHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Success      200      {object}  map[string]interface{}
 // @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
 // @Failure      404      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -250,6 +268,28 @@ func UpdatePlaylistSongHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// Hanya pemilik, admin, dan collaborator playlist yang boleh mengubah lagu di playlist
+	allowed, err := canManagePlaylistID(c, db, playlistSong.PlaylistID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Playlist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengecek akses playlist",
+			"error":   err.Error(),
+		})
+	}
+	if !allowed {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Anda tidak memiliki akses untuk mengubah playlist ini",
+		})
+	}
+
 	var req UpdatePlaylistSongRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -292,6 +332,7 @@ func UpdatePlaylistSongHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        id   path      int  true  "Playlist Song ID"
 // @Success      200  {object}  map[string]interface{}
 // @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -314,6 +355,28 @@ func DeletePlaylistSongHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// Hanya pemilik, admin, dan collaborator playlist yang boleh mengubah lagu di playlist
+	allowed, err := canManagePlaylistID(c, db, playlistSong.PlaylistID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Playlist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengecek akses playlist",
+			"error":   err.Error(),
+		})
+	}
+	if !allowed {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Anda tidak memiliki akses untuk mengubah playlist ini",
+		})
+	}
+
 	if err := db.Delete(&playlistSong).Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -338,6 +401,7 @@ func DeletePlaylistSongHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        music_id     path      int  true  "Music ID"
 // @Success      200          {object}  map[string]interface{}
 // @Failure      401          {object}  map[string]interface{}
+// @Failure      403          {object}  map[string]interface{}
 // @Failure      404          {object}  map[string]interface{}
 // @Failure      500          {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -361,6 +425,28 @@ func DeletePlaylistSongByMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
+	// Hanya pemilik, admin, dan collaborator playlist yang boleh mengubah lagu di playlist
+	allowed, err := canManagePlaylistID(c, db, playlistSong.PlaylistID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Playlist tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengecek akses playlist",
+			"error":   err.Error(),
+		})
+	}
+	if !allowed {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Anda tidak memiliki akses untuk mengubah playlist ini",
+		})
+	}
+
 	if err := db.Delete(&playlistSong).Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
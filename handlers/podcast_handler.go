@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"backend_soundcave/models"
+	"backend_soundcave/utils"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -114,6 +115,7 @@ func CreatePodcastHandler(c *fiber.Ctx, db *gorm.DB) error {
 // @Param        sort_by  query     string  false  "Sort field" default(created_at)
 // @Param        order    query     string  false  "Sort order" default(desc)
 // @Success      200      {object}  map[string]interface{} "Returns a list of podcasts with pagination"
+// @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -151,9 +153,12 @@ func GetPodcastsHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 	// Sort by created_at
 	sortBy := c.Query("sort_by", "created_at")
-	order := c.Query("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	order, err := utils.ParseOrder(c.Query("order"), "desc")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": err.Error(),
+		})
 	}
 	query = query.Order(sortBy + " " + order)
 
@@ -219,6 +224,76 @@ func GetPodcastHandler(c *fiber.Ctx, db *gorm.DB) error {
 	})
 }
 
+// GetNextPodcastHandler mendapatkan episode berikutnya dari podcast yang sama
+// Podcast belum memiliki entitas show tersendiri, sehingga episode dengan host yang sama dianggap satu show.
+// @Summary      Get next podcast episode
+// @Description  Get the following episode of the same show (same host) ordered by season and episode number. Returns null data at the end of the series.
+// @Tags         Podcasts
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Podcast ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /podcasts/{id}/next [get]
+func GetNextPodcastHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+
+	var current models.Podcast
+	if err := db.First(&current, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Podcast tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data podcast",
+			"error":   err.Error(),
+		})
+	}
+
+	season := 0
+	if current.Season != nil {
+		season = *current.Season
+	}
+	episode := 0
+	if current.EpisodeNumber != nil {
+		episode = *current.EpisodeNumber
+	}
+
+	// Urutan: season, episode_number, lalu id sebagai penentu jika nomor episode sama
+	var next models.Podcast
+	err := db.Where("host = ?", current.Host).
+		Where("IFNULL(season, 0) > ? OR (IFNULL(season, 0) = ? AND IFNULL(episode_number, 0) > ?) OR (IFNULL(season, 0) = ? AND IFNULL(episode_number, 0) = ? AND id > ?)",
+			season, season, episode, season, episode, current.ID).
+		Order("IFNULL(season, 0) ASC, IFNULL(episode_number, 0) ASC, id ASC").
+		First(&next).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// Sudah episode terakhir
+			return c.Status(fiber.StatusOK).JSON(fiber.Map{
+				"success": true,
+				"message": "Tidak ada episode berikutnya",
+				"data":    nil,
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil episode berikutnya",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    next,
+	})
+}
+
 // UpdatePodcastHandler mengupdate podcast
 // @Summary      Update podcast
 // @Description  Update podcast information
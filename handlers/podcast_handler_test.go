@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"fmt"
+	"testing"
+)
+
+// testPodcast membuat episode podcast minimal yang valid untuk di-seed
+func testPodcast(title, host string, season, episode int) models.Podcast {
+	return models.Podcast{
+		Title:         title,
+		Host:          host,
+		Duration:      "30:00",
+		Category:      "Talk",
+		Description:   title,
+		Season:        intPtr(season),
+		EpisodeNumber: intPtr(episode),
+		VideoURL:      "https://example.com/" + title + ".mp4",
+	}
+}
+
+func TestGetNextPodcastHandler(t *testing.T) {
+	db := testdb.New(t)
+
+	// Urutan insert sengaja diacak agar urutan tidak bergantung pada id
+	episodes := []models.Podcast{
+		testPodcast("S2E1", "Host A", 2, 1),
+		testPodcast("S1E2", "Host A", 1, 2),
+		testPodcast("S1E1", "Host A", 1, 1),
+		testPodcast("Other S1E3", "Host B", 1, 3),
+	}
+	mustCreate(t, db, &episodes)
+	byTitle := map[string]uint{}
+	for _, episode := range episodes {
+		byTitle[episode.Title] = episode.ID
+	}
+
+	app := newTestApp(db, "GET", "/podcasts/:id/next", 1, "user", GetNextPodcastHandler)
+
+	tests := []struct {
+		name    string
+		current string
+		want    string
+	}{
+		{name: "mid-series", current: "S1E1", want: "S1E2"},
+		{name: "season rollover", current: "S1E2", want: "S2E1"},
+		{name: "last episode", current: "S2E1", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, app, "GET", fmt.Sprintf("/podcasts/%d/next", byTitle[tt.current]), nil)
+			if status != 200 {
+				t.Fatalf("status = %d, body = %v", status, body)
+			}
+			if tt.want == "" {
+				if body["data"] != nil {
+					t.Errorf("data = %v, want null", body["data"])
+				}
+				return
+			}
+			if got := dataMap(t, body)["title"]; got != tt.want {
+				t.Errorf("next = %v, want %s", got, tt.want)
+			}
+		})
+	}
+
+	status, _ := doRequest(t, app, "GET", "/podcasts/9999/next", nil)
+	if status != 404 {
+		t.Errorf("unknown podcast status = %d, want 404", status)
+	}
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// includeDeletedRelations mengecek ?include_deleted_relations=true; hanya berlaku untuk admin
+func includeDeletedRelations(c *fiber.Ctx) bool {
+	role, _ := c.Locals("role").(string)
+	return role == "admin" && c.QueryBool("include_deleted_relations", false)
+}
+
+// relationScope mengembalikan db untuk mengambil relasi, termasuk yang sudah di-soft delete jika diminta admin.
+// Hasil Unscoped dibungkus Session agar kondisi query sebelumnya tidak terbawa ke query berikutnya.
+func relationScope(c *fiber.Ctx, db *gorm.DB) *gorm.DB {
+	if includeDeletedRelations(c) {
+		return db.Unscoped().Session(&gorm.Session{})
+	}
+	return db
+}
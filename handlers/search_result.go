@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"backend_soundcave/config"
+	"backend_soundcave/models"
+	"backend_soundcave/utils"
+	"time"
+)
+
+// MusicSearchResult struct untuk hasil search music yang diringkas.
+// Lyrics hanya disertakan jika diminta lewat ?expand=lyrics.
+type MusicSearchResult struct {
+	ID            uint    `json:"id"`
+	Title         string  `json:"title"`
+	Artist        string  `json:"artist"`
+	ArtistID      int     `json:"artist_id"`
+	Album         *string `json:"album"`
+	AlbumID       *int    `json:"album_id"`
+	Genre         string  `json:"genre"`
+	Duration      string  `json:"duration"`
+	Explicit      *bool   `json:"explicit"`
+	CoverImageURL *string `json:"cover_image_url"`
+	AudioFileURL  string  `json:"audio_file_url"`
+	PlayCount     *int    `json:"play_count"`
+	Description   *string `json:"description"` // Dipotong sesuai SEARCH_TEXT_MAX_LENGTH
+	Lyrics        *string `json:"lyrics,omitempty"`
+}
+
+// NewsSearchResult struct untuk hasil search news yang diringkas.
+// Content hanya disertakan jika diminta lewat ?expand=content.
+type NewsSearchResult struct {
+	ID          uint       `json:"id"`
+	Title       string     `json:"title"`
+	Summary     *string    `json:"summary"` // Dipotong sesuai SEARCH_TEXT_MAX_LENGTH
+	Author      string     `json:"author"`
+	Category    string     `json:"category"`
+	ImageURL    *string    `json:"image_url"`
+	PublishedAt *time.Time `json:"published_at"`
+	Content     *string    `json:"content,omitempty"`
+}
+
+// truncateTextPtr memotong teks opsional tanpa mengubah nilai aslinya
+func truncateTextPtr(text *string, maxLength int) *string {
+	if text == nil {
+		return nil
+	}
+	truncated := utils.TruncateText(*text, maxLength)
+	return &truncated
+}
+
+// toMusicSearchResults mengubah daftar music menjadi hasil search yang diringkas
+func toMusicSearchResults(musics []models.Music, includeLyrics bool) []MusicSearchResult {
+	maxLength := config.GetSearchTextMaxLength()
+
+	results := make([]MusicSearchResult, 0, len(musics))
+	for _, music := range musics {
+		result := MusicSearchResult{
+			ID:            music.ID,
+			Title:         music.Title,
+			Artist:        music.Artist,
+			ArtistID:      music.ArtistID,
+			Album:         music.Album,
+			AlbumID:       music.AlbumID,
+			Genre:         music.Genre,
+			Duration:      music.Duration,
+			Explicit:      music.Explicit,
+			CoverImageURL: music.CoverImageURL,
+			AudioFileURL:  music.AudioFileURL,
+			PlayCount:     music.PlayCount,
+			Description:   truncateTextPtr(music.Description, maxLength),
+		}
+		if includeLyrics {
+			result.Lyrics = music.Lyrics
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// toNewsSearchResults mengubah daftar news menjadi hasil search yang diringkas
+func toNewsSearchResults(news []models.News, includeContent bool) []NewsSearchResult {
+	maxLength := config.GetSearchTextMaxLength()
+
+	results := make([]NewsSearchResult, 0, len(news))
+	for _, item := range news {
+		result := NewsSearchResult{
+			ID:          item.ID,
+			Title:       item.Title,
+			Summary:     truncateTextPtr(item.Summary, maxLength),
+			Author:      item.Author,
+			Category:    item.Category,
+			ImageURL:    item.ImageURL,
+			PublishedAt: item.PublishedAt,
+		}
+		if includeContent {
+			content := item.Content
+			result.Content = &content
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"strings"
+	"testing"
+)
+
+func TestMusicSearchOmitsLyricsByDefault(t *testing.T) {
+	db := testdb.New(t)
+	t.Setenv("SEARCH_TEXT_MAX_LENGTH", "10")
+
+	lyrics := "full song lyrics"
+	description := "a very long description of the song"
+	music := testMusic("Searchable", 1)
+	music.Lyrics = &lyrics
+	music.Description = &description
+	mustCreate(t, db, &music)
+
+	app := newTestApp(db, "GET", "/musics", 1, "user", GetMusicsHandler)
+
+	status, body := doRequest(t, app, "GET", "/musics?search=Search", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	results := dataList(t, body)
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+	if _, ok := results[0]["lyrics"]; ok {
+		t.Errorf("search result includes lyrics without expand: %v", results[0])
+	}
+	if got := results[0]["description"]; got != "a very lon..." {
+		t.Errorf("description = %v, want truncated to SEARCH_TEXT_MAX_LENGTH", got)
+	}
+
+	status, body = doRequest(t, app, "GET", "/musics?search=Search&expand=lyrics", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got := dataList(t, body)[0]["lyrics"]; got != lyrics {
+		t.Errorf("expanded lyrics = %v, want %q", got, lyrics)
+	}
+
+	// Tanpa search, list tetap mengembalikan data lengkap
+	status, body = doRequest(t, app, "GET", "/musics", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if got := dataList(t, body)[0]["lyrics"]; got != lyrics {
+		t.Errorf("list lyrics = %v, want %q", got, lyrics)
+	}
+}
+
+func TestNewsSearchOmitsContentByDefault(t *testing.T) {
+	db := testdb.New(t)
+	mustCreate(t, db, &models.News{
+		Title:    "Breaking",
+		Content:  strings.Repeat("content ", 50),
+		Author:   "Editor",
+		Category: "Music",
+	})
+
+	app := newTestApp(db, "GET", "/news", 1, "user", GetNewsHandler)
+
+	status, body := doRequest(t, app, "GET", "/news?search=Breaking", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if _, ok := dataList(t, body)[0]["content"]; ok {
+		t.Errorf("news search result includes content without expand")
+	}
+
+	status, body = doRequest(t, app, "GET", "/news?search=Breaking&expand=content", nil)
+	if status != 200 {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if _, ok := dataList(t, body)[0]["content"]; !ok {
+		t.Errorf("news search result omits content with expand=content")
+	}
+}
@@ -283,9 +283,9 @@ func InitSocketServer(db *gorm.DB) *socket.Server {
 				}
 			}
 
-			// 2. If not the broadcaster, check if the token provided is an admin token
+			// 2. If not the broadcaster, check if the token provided is an admin token whose session is not revoked
 			if !isAuthorized && token != "" {
-				claims, err := utils.ValidateToken(token)
+				claims, err := utils.ValidateSessionToken(db, token)
 				if err == nil && claims.Role == string(models.RoleAdmin) {
 					isAuthorized = true
 					log.Printf("Admin %s (ID: %d) is stopping stream %s", claims.Email, claims.UserID, streamKey)
@@ -13,6 +13,7 @@ import (
 	"backend_soundcave/config"
 	"backend_soundcave/models"
 
+	"cloud.google.com/go/storage"
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 )
@@ -89,9 +90,9 @@ func UploadImageHandler(c *fiber.Ctx, db *gorm.DB) error {
 	filename := fmt.Sprintf("%d%s", time.Now().UnixNano(), ext)
 	bucketPath := fmt.Sprintf("%s/%s", folder, filename)
 
-	// Upload ke Firebase Storage
-	ctx := context.Background()
-	bucket, err := config.GetStorageBucket()
+	// Upload ke Firebase Storage, context mengikuti request agar upload dibatalkan saat request timeout
+	ctx := c.UserContext()
+	bucket, err := storageBucket()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -107,15 +108,9 @@ func UploadImageHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Buat object writer
+	// Upload file ke Firebase Storage
 	obj := bucket.Object(bucketPath)
-	writer := obj.NewWriter(ctx)
-	writer.ContentType = file.Header.Get("Content-Type")
-	writer.CacheControl = "public, max-age=31536000"
-
-	// Copy file ke Firebase Storage
-	if _, err := io.Copy(writer, src); err != nil {
-		writer.Close()
+	if err := writeStorageObject(ctx, obj, file.Header.Get("Content-Type"), src); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal upload file ke Firebase Storage",
@@ -123,15 +118,6 @@ func UploadImageHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Close writer
-	if err := writer.Close(); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"success": false,
-			"message": "Gagal menutup writer",
-			"error":   err.Error(),
-		})
-	}
-
 	// Set public access
 	if err := obj.ACL().Set(ctx, "allUsers", "READER"); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -170,6 +156,12 @@ func UploadImageHandler(c *fiber.Ctx, db *gorm.DB) error {
 		bucketName,
 		encodedPath)
 
+	// Catat uploader untuk perhitungan storage usage
+	var uploadedBy *uint
+	if userID, ok := c.Locals("user_id").(uint); ok {
+		uploadedBy = &userID
+	}
+
 	// Simpan informasi ke database
 	image := models.Image{
 		FileName:    file.Filename,
@@ -177,6 +169,7 @@ func UploadImageHandler(c *fiber.Ctx, db *gorm.DB) error {
 		FileSize:    file.Size,
 		ContentType: file.Header.Get("Content-Type"),
 		BucketPath:  bucketPath,
+		UploadedBy:  uploadedBy,
 	}
 
 	if err := db.Create(&image).Error; err != nil {
@@ -235,8 +228,15 @@ func UploadMultipleImagesHandler(c *fiber.Ctx, db *gorm.DB) error {
 	var uploadedImages []models.Image
 	var errors []string
 
-	ctx := context.Background()
-	bucket, err := config.GetStorageBucket()
+	// Catat uploader untuk perhitungan storage usage
+	var uploadedBy *uint
+	if userID, ok := c.Locals("user_id").(uint); ok {
+		uploadedBy = &userID
+	}
+
+	// Context mengikuti request agar upload dibatalkan saat request timeout
+	ctx := c.UserContext()
+	bucket, err := storageBucket()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -290,20 +290,10 @@ func UploadMultipleImagesHandler(c *fiber.Ctx, db *gorm.DB) error {
 
 		// Upload ke Firebase
 		obj := bucket.Object(bucketPath)
-		writer := obj.NewWriter(ctx)
-		writer.ContentType = file.Header.Get("Content-Type")
-		writer.CacheControl = "public, max-age=31536000"
-
-		if _, err := io.Copy(writer, src); err != nil {
-			src.Close()
-			writer.Close()
-			errors = append(errors, fmt.Sprintf("%s: gagal upload", file.Filename))
-			continue
-		}
-
+		err = writeStorageObject(ctx, obj, file.Header.Get("Content-Type"), src)
 		src.Close()
-		if err := writer.Close(); err != nil {
-			errors = append(errors, fmt.Sprintf("%s: gagal menutup writer", file.Filename))
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s: gagal upload", file.Filename))
 			continue
 		}
 
@@ -345,6 +335,7 @@ func UploadMultipleImagesHandler(c *fiber.Ctx, db *gorm.DB) error {
 			FileSize:    file.Size,
 			ContentType: file.Header.Get("Content-Type"),
 			BucketPath:  bucketPath,
+			UploadedBy:  uploadedBy,
 		}
 
 		if err := db.Create(&image).Error; err != nil {
@@ -421,8 +412,8 @@ func DeleteImageHandler(c *fiber.Ctx, db *gorm.DB) error {
 	}
 
 	// Hapus dari Firebase Storage
-	ctx := context.Background()
-	bucket, err := config.GetStorageBucket()
+	ctx := c.UserContext()
+	bucket, err := storageBucket()
 	if err == nil {
 		obj := bucket.Object(image.BucketPath)
 		if err := obj.Delete(ctx); err != nil {
@@ -526,9 +517,9 @@ func UploadMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 	filename := fmt.Sprintf("%d%s", time.Now().UnixNano(), ext)
 	bucketPath := fmt.Sprintf("%s/%s", folder, filename)
 
-	// Upload ke Firebase Storage
-	ctx := context.Background()
-	bucket, err := config.GetStorageBucket()
+	// Upload ke Firebase Storage, context mengikuti request agar upload dibatalkan saat request timeout
+	ctx := c.UserContext()
+	bucket, err := storageBucket()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -544,15 +535,9 @@ func UploadMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Buat object writer
+	// Upload file ke Firebase Storage
 	obj := bucket.Object(bucketPath)
-	writer := obj.NewWriter(ctx)
-	writer.ContentType = contentType
-	writer.CacheControl = "public, max-age=31536000"
-
-	// Copy file ke Firebase Storage
-	if _, err := io.Copy(writer, src); err != nil {
-		writer.Close()
+	if err := writeStorageObject(ctx, obj, contentType, src); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal upload file ke Firebase Storage",
@@ -560,15 +545,6 @@ func UploadMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Close writer
-	if err := writer.Close(); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"success": false,
-			"message": "Gagal menutup writer",
-			"error":   err.Error(),
-		})
-	}
-
 	// Set public access
 	if err := obj.ACL().Set(ctx, "allUsers", "READER"); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -605,6 +581,15 @@ func UploadMusicHandler(c *fiber.Ctx, db *gorm.DB) error {
 		bucketName,
 		encodedPath)
 
+	// Catat ukuran file di server untuk perhitungan storage usage
+	if err := recordUploadedFile(c, db, fileURL, bucketPath, file.Size, contentType); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menyimpan data file",
+			"error":   err.Error(),
+		})
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
 		"message": "File music berhasil diupload",
@@ -696,9 +681,9 @@ func UploadMusicVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 	filename := fmt.Sprintf("%d%s", time.Now().UnixNano(), ext)
 	bucketPath := fmt.Sprintf("%s/%s", folder, filename)
 
-	// Upload ke Firebase Storage
-	ctx := context.Background()
-	bucket, err := config.GetStorageBucket()
+	// Upload ke Firebase Storage, context mengikuti request agar upload dibatalkan saat request timeout
+	ctx := c.UserContext()
+	bucket, err := storageBucket()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -714,15 +699,9 @@ func UploadMusicVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Buat object writer
+	// Upload file ke Firebase Storage
 	obj := bucket.Object(bucketPath)
-	writer := obj.NewWriter(ctx)
-	writer.ContentType = contentType
-	writer.CacheControl = "public, max-age=31536000"
-
-	// Copy file ke Firebase Storage
-	if _, err := io.Copy(writer, src); err != nil {
-		writer.Close()
+	if err := writeStorageObject(ctx, obj, contentType, src); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal upload file ke Firebase Storage",
@@ -730,15 +709,6 @@ func UploadMusicVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Close writer
-	if err := writer.Close(); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"success": false,
-			"message": "Gagal menutup writer",
-			"error":   err.Error(),
-		})
-	}
-
 	// Set public access
 	if err := obj.ACL().Set(ctx, "allUsers", "READER"); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -775,6 +745,15 @@ func UploadMusicVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		bucketName,
 		encodedPath)
 
+	// Catat ukuran file di server untuk perhitungan storage usage
+	if err := recordUploadedFile(c, db, fileURL, bucketPath, file.Size, contentType); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menyimpan data file",
+			"error":   err.Error(),
+		})
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
 		"message": "File music video berhasil diupload",
@@ -866,9 +845,9 @@ func UploadPodcastVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 	filename := fmt.Sprintf("%d%s", time.Now().UnixNano(), ext)
 	bucketPath := fmt.Sprintf("%s/%s", folder, filename)
 
-	// Upload ke Firebase Storage
-	ctx := context.Background()
-	bucket, err := config.GetStorageBucket()
+	// Upload ke Firebase Storage, context mengikuti request agar upload dibatalkan saat request timeout
+	ctx := c.UserContext()
+	bucket, err := storageBucket()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -884,15 +863,9 @@ func UploadPodcastVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Buat object writer
+	// Upload file ke Firebase Storage
 	obj := bucket.Object(bucketPath)
-	writer := obj.NewWriter(ctx)
-	writer.ContentType = contentType
-	writer.CacheControl = "public, max-age=31536000"
-
-	// Copy file ke Firebase Storage
-	if _, err := io.Copy(writer, src); err != nil {
-		writer.Close()
+	if err := writeStorageObject(ctx, obj, contentType, src); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal upload file ke Firebase Storage",
@@ -900,15 +873,6 @@ func UploadPodcastVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Close writer
-	if err := writer.Close(); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"success": false,
-			"message": "Gagal menutup writer",
-			"error":   err.Error(),
-		})
-	}
-
 	// Set public access
 	if err := obj.ACL().Set(ctx, "allUsers", "READER"); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -1036,9 +1000,9 @@ func UploadCavelistVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 	filename := fmt.Sprintf("%d%s", time.Now().UnixNano(), ext)
 	bucketPath := fmt.Sprintf("%s/%s", folder, filename)
 
-	// Upload ke Firebase Storage
-	ctx := context.Background()
-	bucket, err := config.GetStorageBucket()
+	// Upload ke Firebase Storage, context mengikuti request agar upload dibatalkan saat request timeout
+	ctx := c.UserContext()
+	bucket, err := storageBucket()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
@@ -1054,15 +1018,9 @@ func UploadCavelistVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Buat object writer
+	// Upload file ke Firebase Storage
 	obj := bucket.Object(bucketPath)
-	writer := obj.NewWriter(ctx)
-	writer.ContentType = contentType
-	writer.CacheControl = "public, max-age=31536000"
-
-	// Copy file ke Firebase Storage
-	if _, err := io.Copy(writer, src); err != nil {
-		writer.Close()
+	if err := writeStorageObject(ctx, obj, contentType, src); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
 			"message": "Gagal upload file ke Firebase Storage",
@@ -1070,15 +1028,6 @@ func UploadCavelistVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		})
 	}
 
-	// Close writer
-	if err := writer.Close(); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"success": false,
-			"message": "Gagal menutup writer",
-			"error":   err.Error(),
-		})
-	}
-
 	// Set public access
 	if err := obj.ACL().Set(ctx, "allUsers", "READER"); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -1115,6 +1064,15 @@ func UploadCavelistVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		bucketName,
 		encodedPath)
 
+	// Catat ukuran file di server untuk perhitungan storage usage
+	if err := recordUploadedFile(c, db, fileURL, bucketPath, file.Size, contentType); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal menyimpan data file",
+			"error":   err.Error(),
+		})
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
 		"message": "File podcast video berhasil diupload",
@@ -1127,3 +1085,54 @@ func UploadCavelistVideoHandler(c *fiber.Ctx, db *gorm.DB) error {
 		},
 	})
 }
+
+// storageBucket mengembalikan bucket Firebase Storage, dapat diganti di test
+var storageBucket = config.GetStorageBucket
+
+// recordUploadedFile mencatat file yang berhasil diupload beserta ukurannya yang diterima server
+func recordUploadedFile(c *fiber.Ctx, db *gorm.DB, fileURL, bucketPath string, fileSize int64, contentType string) error {
+	uploaded := models.UploadedFile{
+		FileURL:     fileURL,
+		BucketPath:  bucketPath,
+		FileSize:    fileSize,
+		ContentType: contentType,
+	}
+	if userID, ok := c.Locals("user_id").(uint); ok {
+		uploaded.UploadedBy = &userID
+	}
+	return db.Create(&uploaded).Error
+}
+
+// uploadedFileSize mengembalikan ukuran file yang tercatat saat upload.
+// Mengembalikan nil jika URL tidak berasal dari endpoint upload.
+func uploadedFileSize(db *gorm.DB, fileURL string) (*int64, error) {
+	var uploaded models.UploadedFile
+	err := db.Select("file_size").Where("file_url = ?", fileURL).Order("id DESC").First(&uploaded).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &uploaded.FileSize, nil
+}
+
+// writeStorageObject menulis isi src ke object Firebase Storage.
+// Upload dibatalkan tanpa menyimpan object parsial jika ctx selesai, misalnya saat request timeout.
+// Client yang memutus koneksi tidak membatalkan ctx (fasthttp tidak mendeteksinya), hanya timeout yang berlaku.
+func writeStorageObject(ctx context.Context, obj *storage.ObjectHandle, contentType string, src io.Reader) error {
+	writerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writer := obj.NewWriter(writerCtx)
+	writer.ContentType = contentType
+	writer.CacheControl = "public, max-age=31536000"
+
+	if _, err := io.Copy(writer, src); err != nil {
+		cancel() // Batalkan upload agar object parsial tidak tersimpan
+		writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+)
+
+// newTestBucket membuat bucket Storage yang diarahkan ke server HTTP test
+func newTestBucket(t *testing.T, handler http.HandlerFunc) *storage.BucketHandle {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := storage.NewClient(context.Background(),
+		option.WithEndpoint(server.URL+"/storage/v1/"),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("gagal membuat storage client: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	return client.Bucket("soundcave-test")
+}
+
+func TestWriteStorageObjectUploadsFile(t *testing.T) {
+	var received atomic.Int64
+	bucket := newTestBucket(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received.Add(int64(len(body)))
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"bucket":"soundcave-test","name":"musics/song.mp3"}`)
+	})
+
+	err := writeStorageObject(context.Background(), bucket.Object("musics/song.mp3"), "audio/mpeg", strings.NewReader("audio-bytes"))
+	if err != nil {
+		t.Fatalf("writeStorageObject error = %v", err)
+	}
+	if received.Load() == 0 {
+		t.Error("storage server did not receive the upload")
+	}
+}
+
+func TestWriteStorageObjectAbortsWhenContextIsCancelled(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	bucket := newTestBucket(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		// Simulasikan upload yang lambat: tunggu sampai client memutus koneksi
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+	// Didaftarkan setelah server agar handler dilepas sebelum server ditutup
+	t.Cleanup(func() { close(release) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- writeStorageObject(ctx, bucket.Object("musics/big.mp3"), "audio/mpeg", strings.NewReader("audio-bytes"))
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("writeStorageObject succeeded after the context was cancelled")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("writeStorageObject did not abort after the context was cancelled")
+	}
+}
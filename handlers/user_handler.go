@@ -383,3 +383,50 @@ func DeleteUserHandler(c *fiber.Ctx, db *gorm.DB) error {
 		"message": "User berhasil dihapus",
 	})
 }
+
+// RevokeUserSessionsHandler mencabut semua sesi (token) user tanpa mengubah password
+// @Summary      Revoke user sessions
+// @Description  Invalidate every token previously issued to a user so they must log in again. The password is not changed (admin only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /admin/users/{id}/revoke-sessions [post]
+func RevokeUserSessionsHandler(c *fiber.Ctx, db *gorm.DB) error {
+	id := c.Params("id")
+
+	var user models.User
+	if err := db.First(&user, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "User tidak ditemukan",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mengambil data user",
+			"error":   err.Error(),
+		})
+	}
+
+	// Naikkan token_version sehingga semua token lama tidak lagi cocok
+	if err := db.Model(&user).UpdateColumn("token_version", gorm.Expr("token_version + ?", 1)).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Gagal mencabut sesi user",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Semua sesi user berhasil dicabut",
+	})
+}
@@ -0,0 +1,88 @@
+// Package testdb menyediakan database MySQL in-memory untuk test.
+// Setiap test mendapat database baru yang sudah di-migrate dengan semua model.
+package testdb
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"backend_soundcave/database"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var (
+	startOnce sync.Once
+	address   string
+	startErr  error
+	counter   atomic.Int64
+)
+
+// start menjalankan satu server MySQL in-memory untuk seluruh test dalam satu package
+func start() {
+	logrus.SetLevel(logrus.ErrorLevel)
+
+	provider := memory.NewDBProvider()
+	engine := sqle.NewDefault(provider)
+	srv, err := server.NewServer(server.Config{Protocol: "tcp", Address: "127.0.0.1:0"}, engine, sql.NewContext, memory.NewSessionBuilder(provider), nil)
+	if err != nil {
+		startErr = err
+		return
+	}
+	go srv.Start()
+	address = srv.Listener.Addr().String()
+}
+
+// New membuat database baru yang sudah di-migrate untuk satu test
+func New(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	startOnce.Do(start)
+	if startErr != nil {
+		t.Fatalf("gagal menjalankan test database: %v", startErr)
+	}
+
+	name := fmt.Sprintf("soundcave_test_%d", counter.Add(1))
+	config := &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+		// Server in-memory menolak foreign key dengan tipe kolom berbeda (artist_streams.artist_id)
+		DisableForeignKeyConstraintWhenMigrating: true,
+	}
+
+	root, err := gorm.Open(mysql.Open(fmt.Sprintf("root:@tcp(%s)/?parseTime=true&loc=Local", address)), config)
+	if err != nil {
+		t.Fatalf("gagal koneksi ke test database: %v", err)
+	}
+	if err := root.Exec("CREATE DATABASE " + name).Error; err != nil {
+		t.Fatalf("gagal membuat test database: %v", err)
+	}
+	if sqlDB, err := root.DB(); err == nil {
+		sqlDB.Close()
+	}
+
+	db, err := gorm.Open(mysql.Open(fmt.Sprintf("root:@tcp(%s)/%s?parseTime=true&loc=Local", address, name)), config)
+	if err != nil {
+		t.Fatalf("gagal koneksi ke test database: %v", err)
+	}
+	if err := database.Migrate(db); err != nil {
+		t.Fatalf("gagal migrate test database: %v", err)
+	}
+
+	t.Cleanup(func() {
+		db.Exec("DROP DATABASE " + name)
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	return db
+}
@@ -8,6 +8,7 @@ import (
 	"backend_soundcave/database"
 	_ "backend_soundcave/docs" // Swagger docs
 	"backend_soundcave/handlers"
+	"backend_soundcave/middleware"
 	"backend_soundcave/routes"
 
 	"github.com/gofiber/fiber/v2"
@@ -73,6 +74,11 @@ func main() {
 	}
 	log.Println("✓ Koneksi database berhasil")
 
+	// Buat admin pertama jika BOOTSTRAP_ADMIN_EMAIL/PASSWORD di-set dan belum ada admin
+	if err := database.BootstrapAdmin(db); err != nil {
+		log.Printf("WARNING: Gagal bootstrap admin: %v", err)
+	}
+
 	// Initialize Firebase
 	log.Println("Mencoba inisialisasi Firebase...")
 	firebaseApp, err := config.InitFirebase()
@@ -91,6 +97,9 @@ func main() {
 	app.Use(recover.New())
 	app.Use(logger.New())
 
+	// Request timeout untuk semua API routes (upload routes mendapat batas waktu lebih besar)
+	app.Use("/api", middleware.TimeoutMiddleware(config.GetRequestTimeout(), config.GetUploadRequestTimeout()))
+
 	// Rate limiting: 30 requests per minute (DISABLED)
 	// app.Use(limiter.New(limiter.Config{
 	// 	Max:        30,
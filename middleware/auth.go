@@ -2,65 +2,82 @@ package middleware
 
 import (
 	"backend_soundcave/utils"
+	"errors"
 	"log"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
 )
 
-// AuthMiddleware middleware untuk memverifikasi JWT token
-func AuthMiddleware(c *fiber.Ctx) error {
-	authHeader := c.Get("Authorization")
-	// Debug logging for Swagger 401 issue
-	if strings.HasPrefix(c.Path(), "/api/swagger") || strings.Contains(c.Path(), "/docs/") {
-		// skip logging for swagger static files
-	} else if authHeader != "" {
-		// Only log the first few characters of the token for security
-		tokenPreview := ""
-		if len(authHeader) > 20 {
-			tokenPreview = authHeader[:20] + "..."
+// AuthMiddleware middleware untuk memverifikasi JWT token.
+// db dipakai untuk mengecek token_version user agar token dari sesi yang sudah dicabut ditolak.
+func AuthMiddleware(db *gorm.DB) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		authHeader := c.Get("Authorization")
+		// Debug logging for Swagger 401 issue
+		if strings.HasPrefix(c.Path(), "/api/swagger") || strings.Contains(c.Path(), "/docs/") {
+			// skip logging for swagger static files
+		} else if authHeader != "" {
+			// Only log the first few characters of the token for security
+			tokenPreview := ""
+			if len(authHeader) > 20 {
+				tokenPreview = authHeader[:20] + "..."
+			} else {
+				tokenPreview = authHeader
+			}
+			log.Printf("[DEBUG] AuthMiddleware: Received Authorization header: %s", tokenPreview)
 		} else {
-			tokenPreview = authHeader
+			log.Printf("[DEBUG] AuthMiddleware: No Authorization header received for path: %s", c.Path())
 		}
-		log.Printf("[DEBUG] AuthMiddleware: Received Authorization header: %s", tokenPreview)
-	} else {
-		log.Printf("[DEBUG] AuthMiddleware: No Authorization header received for path: %s", c.Path())
-	}
 
-	if authHeader == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"success": false,
-			"message": "Token tidak ditemukan",
-		})
-	}
+		if authHeader == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"success": false,
+				"message": "Token tidak ditemukan",
+			})
+		}
 
-	// Extract token dari "Bearer <token>"
-	parts := strings.Split(authHeader, " ")
-	if len(parts) != 2 || parts[0] != "Bearer" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"success": false,
-			"message": "Format token tidak valid",
-		})
-	}
+		// Extract token dari "Bearer <token>"
+		parts := strings.Split(authHeader, " ")
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"success": false,
+				"message": "Format token tidak valid",
+			})
+		}
 
-	token := parts[1]
+		token := parts[1]
 
-	// Validate token
-	claims, err := utils.ValidateToken(token)
-	if err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"success": false,
-			"message": "Token tidak valid atau sudah expired",
-			"error":   err.Error(),
-		})
-	}
+		// Validate token, token yang diterbitkan sebelum sesi dicabut ditolak
+		claims, err := utils.ValidateSessionToken(db.WithContext(c.UserContext()), token)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"success": false,
+					"message": "User tidak ditemukan",
+				})
+			}
+			if errors.Is(err, utils.ErrTokenRevoked) {
+				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"success": false,
+					"message": "Sesi sudah dicabut, silakan login kembali",
+				})
+			}
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"success": false,
+				"message": "Token tidak valid atau sudah expired",
+				"error":   err.Error(),
+			})
+		}
 
-	// Simpan claims ke context
-	c.Locals("user_id", uint(claims.UserID))
-	c.Locals("email", claims.Email)
-	c.Locals("role", claims.Role)
+		// Simpan claims ke context
+		c.Locals("user_id", uint(claims.UserID))
+		c.Locals("email", claims.Email)
+		c.Locals("role", claims.Role)
 
-	return c.Next()
+		return c.Next()
+	}
 }
 
 // AdminMiddleware middleware untuk memverifikasi admin role
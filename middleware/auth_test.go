@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"backend_soundcave/handlers"
+	"backend_soundcave/internal/testdb"
+	"backend_soundcave/models"
+	"backend_soundcave/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestAuthMiddlewareRejectsTokenAfterSessionsRevoked(t *testing.T) {
+	db := testdb.New(t)
+	admin := models.User{FullName: "Admin", Email: "admin@example.com", Role: models.RoleAdmin}
+	user := models.User{FullName: "User", Email: "user@example.com", Role: models.RoleUser}
+	for _, u := range []*models.User{&admin, &user} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatalf("gagal seed user: %v", err)
+		}
+	}
+
+	app := fiber.New()
+	app.Get("/me", AuthMiddleware(db), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	app.Post("/admin/users/:id/revoke-sessions", AuthMiddleware(db), AdminMiddleware, func(c *fiber.Ctx) error {
+		return handlers.RevokeUserSessionsHandler(c, db)
+	})
+
+	send := func(method, target, token string) int {
+		t.Helper()
+		req := httptest.NewRequest(method, target, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	adminToken, _ := utils.GenerateToken(admin.ID, admin.Email, string(admin.Role), admin.TokenVersion)
+	userToken, _ := utils.GenerateToken(user.ID, user.Email, string(user.Role), user.TokenVersion)
+
+	if status := send("GET", "/me", userToken); status != fiber.StatusOK {
+		t.Fatalf("status before revoke = %d, want 200", status)
+	}
+
+	if status := send("POST", fmt.Sprintf("/admin/users/%d/revoke-sessions", user.ID), adminToken); status != fiber.StatusOK {
+		t.Fatalf("revoke status = %d, want 200", status)
+	}
+
+	if status := send("GET", "/me", userToken); status != fiber.StatusUnauthorized {
+		t.Errorf("prior token status = %d, want 401", status)
+	}
+	if _, err := utils.ValidateSessionToken(db, userToken); err != utils.ErrTokenRevoked {
+		t.Errorf("ValidateSessionToken error = %v, want ErrTokenRevoked", err)
+	}
+
+	// Token baru dengan token_version terbaru tetap diterima
+	var refreshed models.User
+	db.First(&refreshed, user.ID)
+	newToken, _ := utils.GenerateToken(refreshed.ID, refreshed.Email, string(refreshed.Role), refreshed.TokenVersion)
+	if status := send("GET", "/me", newToken); status != fiber.StatusOK {
+		t.Errorf("new token status = %d, want 200", status)
+	}
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TimeoutMiddleware membatasi durasi setiap request melalui context yang dibatalkan saat timeout.
+// Route upload menggunakan uploadTimeout karena file audio/video bisa berukuran ratusan MB.
+// Handler harus memakai c.UserContext() (atau db yang sudah WithContext) agar query dan upload ikut dibatalkan.
+func TimeoutMiddleware(timeout, uploadTimeout time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		duration := timeout
+		if isUploadPath(c.Path()) {
+			duration = uploadTimeout
+		}
+
+		// 0 berarti tanpa batas waktu
+		if duration <= 0 {
+			return c.Next()
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), duration)
+		defer cancel()
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return c.Status(fiber.StatusGatewayTimeout).JSON(fiber.Map{
+				"success": false,
+				"message": "Request melebihi batas waktu",
+			})
+		}
+
+		return err
+	}
+}
+
+// isUploadPath mengecek apakah path merupakan route upload file
+func isUploadPath(path string) bool {
+	return strings.HasSuffix(path, "/upload") || strings.HasSuffix(path, "/upload-multiple")
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestTimeoutMiddlewareReturns504ForSlowHandler(t *testing.T) {
+	app := fiber.New()
+	app.Use(TimeoutMiddleware(20*time.Millisecond, time.Second))
+	app.Get("/slow", func(c *fiber.Ctx) error {
+		select {
+		case <-c.UserContext().Done():
+			return c.UserContext().Err()
+		case <-time.After(time.Second):
+			return c.SendString("done")
+		}
+	})
+	app.Get("/fast", func(c *fiber.Ctx) error {
+		return c.SendString("done")
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/slow", nil), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusGatewayTimeout {
+		t.Errorf("slow status = %d, want 504", resp.StatusCode)
+	}
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/fast", nil), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Errorf("fast status = %d, want 200", resp.StatusCode)
+	}
+}
+
+func TestTimeoutMiddlewareUsesUploadTimeoutForUploadRoutes(t *testing.T) {
+	app := fiber.New()
+	app.Use(TimeoutMiddleware(20*time.Millisecond, time.Second))
+	app.Post("/api/images/upload", func(c *fiber.Ctx) error {
+		select {
+		case <-c.UserContext().Done():
+			return c.UserContext().Err()
+		case <-time.After(50 * time.Millisecond):
+			return c.SendString("uploaded")
+		}
+	})
+
+	resp, err := app.Test(httptest.NewRequest("POST", "/api/images/upload", nil), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Errorf("upload status = %d, want 200", resp.StatusCode)
+	}
+}
@@ -31,6 +31,9 @@ type Album struct {
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"index" swag:"-"`
+
+	// Relations (tanpa foreign key constraint untuk menghindari error migration)
+	ArtistData *Artist `json:"artist_data,omitempty" gorm:"foreignKey:ArtistID;constraint:-"`
 }
 
 // TableName mengembalikan nama tabel
@@ -25,9 +25,13 @@ type Artist struct {
 	Followers     JSONStringArray `json:"followers" gorm:"type:json"`
 	TotalFollower int             `json:"total_follower" gorm:"default:0"`
 	IsHighlight   *int            `json:"is_highlight" gorm:"type:tinyint(1);default:0"`
+	PinnedMusicID *uint           `json:"pinned_music_id" gorm:"index"` // Lagu yang ditampilkan paling atas di halaman artist
 	CreatedAt     time.Time       `json:"created_at"`
 	UpdatedAt     time.Time       `json:"updated_at"`
 	DeletedAt     gorm.DeletedAt  `json:"deleted_at" gorm:"index" swag:"-"`
+
+	// Relations (tanpa foreign key constraint untuk menghindari error migration)
+	PinnedMusic *Music `json:"pinned_music,omitempty" gorm:"foreignKey:PinnedMusicID;constraint:-"`
 }
 
 // TableName mengembalikan nama tabel
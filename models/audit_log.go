@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+// AuditLog model untuk mencatat aksi admin yang mengubah data
+type AuditLog struct {
+	ID         uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID     uint      `json:"user_id" gorm:"not null;index"`
+	Action     string    `json:"action" gorm:"size:100;not null;index"`
+	EntityType string    `json:"entity_type" gorm:"size:50;not null;index:idx_entity"`
+	EntityID   uint      `json:"entity_id" gorm:"not null;index:idx_entity"`
+	Details    *string   `json:"details" gorm:"type:text"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TableName mengembalikan nama tabel
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
@@ -25,6 +25,7 @@ type Cavelist struct {
 	Likes           *int           `json:"likes" gorm:"default:0"`
 	Shares          *int           `json:"shares" gorm:"default:0"`
 	VideoURL        string         `json:"video_url" gorm:"size:500;not null"`
+	VideoFileSize   *int64         `json:"video_file_size"` // Ukuran file video dalam bytes
 	ArtistID        int            `json:"artist_id" gorm:"not null;index"`
 	ArtistName      string         `json:"artist_name" gorm:"size:255;not null"`
 	Status          CavelistStatus `json:"status" gorm:"type:enum('draft','publish');default:'draft'"`
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+)
+
+// CavelistEventType enum untuk jenis event cavelist
+type CavelistEventType string
+
+const (
+	CavelistEventView  CavelistEventType = "view"
+	CavelistEventLike  CavelistEventType = "like"
+	CavelistEventShare CavelistEventType = "share"
+)
+
+// CavelistEvent model untuk mencatat setiap view/like/share cavelist
+type CavelistEvent struct {
+	ID         uint              `json:"id" gorm:"primaryKey;autoIncrement"`
+	CavelistID uint              `json:"cavelist_id" gorm:"not null;index:idx_cavelist_created"`
+	UserID     uint              `json:"user_id" gorm:"not null;index"`
+	Type       CavelistEventType `json:"type" gorm:"type:enum('view','like','share');not null"`
+	CreatedAt  time.Time         `json:"created_at" gorm:"index:idx_cavelist_created"`
+}
+
+// TableName mengembalikan nama tabel
+func (CavelistEvent) TableName() string {
+	return "cavelist_events"
+}
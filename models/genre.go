@@ -8,14 +8,15 @@ import (
 
 // Genre model sesuai struktur tabel
 type Genre struct {
-	ID          uint           `json:"id" gorm:"primaryKey;autoIncrement"`
-	Name        string         `json:"name" gorm:"size:100;not null;uniqueIndex"`
-	Description string         `json:"description" gorm:"type:text;not null"`
-	Color       *string        `json:"color" gorm:"size:7"`         // Hex color code (e.g., #FF5733)
-	Background  *string        `json:"background" gorm:"type:text"` // Background image URL or path
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"index" swag:"-"`
+	ID              uint           `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name            string         `json:"name" gorm:"size:100;not null;uniqueIndex"`
+	Description     string         `json:"description" gorm:"type:text;not null"`
+	Color           *string        `json:"color" gorm:"size:7"`               // Hex color code (e.g., #FF5733)
+	Background      *string        `json:"background" gorm:"type:text"`       // Background image URL or path
+	EditorialWeight int            `json:"editorial_weight" gorm:"default:0"` // Boost editorial untuk semua music di genre ini
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `json:"deleted_at" gorm:"index" swag:"-"`
 }
 
 // TableName mengembalikan nama tabel
@@ -14,6 +14,7 @@ type Image struct {
 	FileSize    int64          `json:"file_size"`
 	ContentType string         `json:"content_type" gorm:"size:100"`
 	BucketPath  string         `json:"bucket_path" gorm:"size:500"`
+	UploadedBy  *uint          `json:"uploaded_by" gorm:"index"` // User yang mengupload gambar
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"index" swag:"-"`
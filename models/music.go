@@ -8,33 +8,37 @@ import (
 
 // Music model sesuai struktur tabel
 type Music struct {
-	ID            uint           `json:"id" gorm:"primaryKey;autoIncrement"`
-	Title         string         `json:"title" gorm:"size:255;not null"`
-	Artist        string         `json:"artist" gorm:"size:255;not null"`
-	ArtistID      int            `json:"artist_id" gorm:"not null;index"`
-	Album         *string        `json:"album" gorm:"size:255"`
-	AlbumID       *int           `json:"album_id" gorm:"index"`
-	Genre         string         `json:"genre" gorm:"size:100;not null"`
-	ReleaseDate   *time.Time     `json:"release_date" gorm:"type:date"`
-	Duration      string         `json:"duration" gorm:"size:10;not null"` // Format: MM:SS atau HH:MM:SS
-	Language      string         `json:"language" gorm:"size:50;not null"`
-	Explicit      *bool          `json:"explicit" gorm:"type:tinyint(1);default:0"`
-	Lyrics        *string        `json:"lyrics" gorm:"type:text"`
-	Description   *string        `json:"description" gorm:"type:text"`
-	Tags          *string        `json:"tags" gorm:"type:text"`
-	AudioFileURL  string         `json:"audio_file_url" gorm:"size:500;not null"`
-	CoverImageURL *string        `json:"cover_image_url" gorm:"size:500"`
-	PlayCount     *int           `json:"play_count" gorm:"default:0"`
-	LikeCount     *int           `json:"like_count" gorm:"default:0"`
-	TotalStream   *int           `json:"total_stream" gorm:"default:0"`
-	SubmittedBy   string         `json:"submitted_by" gorm:"type:enum('artist','label','admin');default:'artist'"`
-	IsApproved    *int           `json:"is_approved" gorm:"type:tinyint(1);default:0"`
-	ApprovedBy    *int           `json:"approved_by" gorm:"index"`
-	IsTop100      *int           `json:"is_top100" gorm:"type:tinyint(1);default:0"`
-	Notes         *string        `json:"notes" gorm:"type:text"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	DeletedAt     gorm.DeletedAt `json:"deleted_at" gorm:"index" swag:"-"`
+	ID              uint           `json:"id" gorm:"primaryKey;autoIncrement"`
+	Title           string         `json:"title" gorm:"size:255;not null"`
+	Artist          string         `json:"artist" gorm:"size:255;not null"`
+	ArtistID        int            `json:"artist_id" gorm:"not null;index"`
+	Album           *string        `json:"album" gorm:"size:255"`
+	AlbumID         *int           `json:"album_id" gorm:"index"`
+	Genre           string         `json:"genre" gorm:"size:100;not null"`
+	ReleaseDate     *time.Time     `json:"release_date" gorm:"type:date"`
+	Duration        string         `json:"duration" gorm:"size:10;not null"` // Format: MM:SS atau HH:MM:SS
+	Language        string         `json:"language" gorm:"size:50;not null"`
+	Explicit        *bool          `json:"explicit" gorm:"type:tinyint(1);default:0"`
+	Lyrics          *string        `json:"lyrics" gorm:"type:text"`
+	Description     *string        `json:"description" gorm:"type:text"`
+	Tags            *string        `json:"tags" gorm:"type:text"`
+	AudioFileURL    string         `json:"audio_file_url" gorm:"size:500;not null"`
+	AudioFileSize   *int64         `json:"audio_file_size"` // Ukuran file audio dalam bytes
+	CoverImageURL   *string        `json:"cover_image_url" gorm:"size:500"`
+	PlayCount       *int           `json:"play_count" gorm:"default:0"`
+	LikeCount       *int           `json:"like_count" gorm:"default:0"`
+	TotalStream     *int           `json:"total_stream" gorm:"default:0"`
+	SubmittedBy     string         `json:"submitted_by" gorm:"type:enum('artist','label','admin');default:'artist'"`
+	IsApproved      *int           `json:"is_approved" gorm:"type:tinyint(1);default:0"`
+	ApprovedBy      *int           `json:"approved_by" gorm:"index"`
+	IsTop100        *int           `json:"is_top100" gorm:"type:tinyint(1);default:0"`
+	EditorialWeight int            `json:"editorial_weight" gorm:"default:0"` // Boost editorial untuk ?sort=editorial
+	Notes           *string        `json:"notes" gorm:"type:text"`
+	ParentMusicID   *uint          `json:"parent_music_id" gorm:"index"` // Music utama jika ini versi lain (explicit/clean) dari lagu yang sama
+	VersionLabel    *string        `json:"version_label" gorm:"size:50"` // Contoh: explicit, clean, radio edit
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `json:"deleted_at" gorm:"index" swag:"-"`
 }
 
 // TableName mengembalikan nama tabel
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// MusicGenre model untuk relasi many-to-many music dan genre
+type MusicGenre struct {
+	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	MusicID   uint      `json:"music_id" gorm:"not null;index:idx_music_genre,unique"`
+	GenreID   uint      `json:"genre_id" gorm:"not null;index:idx_music_genre,unique;index"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName mengembalikan nama tabel
+func (MusicGenre) TableName() string {
+	return "music_genres"
+}
@@ -8,24 +8,25 @@ import (
 
 // MusicVideo model sesuai struktur tabel
 type MusicVideo struct {
-	ID          uint           `json:"id" gorm:"primaryKey;autoIncrement"`
-	Title       string         `json:"title" gorm:"size:255;not null"`
-	ArtistID    int            `json:"artist_id" gorm:"not null;index"`
-	Artist      string         `json:"artist" gorm:"size:255;not null"`
-	ReleaseDate *time.Time     `json:"release_date" gorm:"type:date"`
-	Duration    string         `json:"duration" gorm:"size:10;not null"` // Format: MM:SS atau HH:MM:SS
-	Genre       string         `json:"genre" gorm:"size:100;not null"`
-	Description *string        `json:"description" gorm:"type:text"`
-	VideoURL    string         `json:"video_url" gorm:"size:500;not null"`
-	Thumbnail   *string        `json:"thumbnail" gorm:"size:255"`
-	TotalStream *int           `json:"total_stream" gorm:"default:0"`
-	IsApproved  *int           `json:"is_approved" gorm:"type:tinyint;default:0"`
-	ApprovedBy  *int           `json:"approved_by" gorm:"index"`
-	SubmittedBy string         `json:"submitted_by" gorm:"type:enum('artist','label','admin');default:'artist'"`
-	IsHighlight *int           `json:"is_highlight" gorm:"type:tinyint(1);default:0"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"index" swag:"-"`
+	ID            uint           `json:"id" gorm:"primaryKey;autoIncrement"`
+	Title         string         `json:"title" gorm:"size:255;not null"`
+	ArtistID      int            `json:"artist_id" gorm:"not null;index"`
+	Artist        string         `json:"artist" gorm:"size:255;not null"`
+	ReleaseDate   *time.Time     `json:"release_date" gorm:"type:date"`
+	Duration      string         `json:"duration" gorm:"size:10;not null"` // Format: MM:SS atau HH:MM:SS
+	Genre         string         `json:"genre" gorm:"size:100;not null"`
+	Description   *string        `json:"description" gorm:"type:text"`
+	VideoURL      string         `json:"video_url" gorm:"size:500;not null"`
+	VideoFileSize *int64         `json:"video_file_size"` // Ukuran file video dalam bytes
+	Thumbnail     *string        `json:"thumbnail" gorm:"size:255"`
+	TotalStream   *int           `json:"total_stream" gorm:"default:0"`
+	IsApproved    *int           `json:"is_approved" gorm:"type:tinyint;default:0"`
+	ApprovedBy    *int           `json:"approved_by" gorm:"index"`
+	SubmittedBy   string         `json:"submitted_by" gorm:"type:enum('artist','label','admin');default:'artist'"`
+	IsHighlight   *int           `json:"is_highlight" gorm:"type:tinyint(1);default:0"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `json:"deleted_at" gorm:"index" swag:"-"`
 }
 
 // TableName mengembalikan nama tabel
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+)
+
+// NotificationPreference model untuk pengaturan notifikasi per user.
+// User tanpa record dianggap menerima semua type.
+type NotificationPreference struct {
+	ID             uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID         uint      `json:"user_id" gorm:"not null;uniqueIndex"`
+	InfoEnabled    *bool     `json:"info_enabled" gorm:"type:tinyint(1);default:1"`
+	SuccessEnabled *bool     `json:"success_enabled" gorm:"type:tinyint(1);default:1"`
+	WarningEnabled *bool     `json:"warning_enabled" gorm:"type:tinyint(1);default:1"`
+	ErrorEnabled   *bool     `json:"error_enabled" gorm:"type:tinyint(1);default:1"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TableName mengembalikan nama tabel
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
+
+// IsTypeEnabled mengecek apakah user menerima notification dengan type tertentu
+func (p NotificationPreference) IsTypeEnabled(notificationType NotificationType) bool {
+	var enabled *bool
+	switch notificationType {
+	case NotificationTypeInfo:
+		enabled = p.InfoEnabled
+	case NotificationTypeSuccess:
+		enabled = p.SuccessEnabled
+	case NotificationTypeWarning:
+		enabled = p.WarningEnabled
+	case NotificationTypeError:
+		enabled = p.ErrorEnabled
+	}
+	return enabled == nil || *enabled
+}
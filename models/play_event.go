@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// PlayEvent model untuk mencatat setiap kali music diputar
+type PlayEvent struct {
+	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	MusicID   uint      `json:"music_id" gorm:"not null;index:idx_music_created"`
+	UserID    uint      `json:"user_id" gorm:"not null;index"`
+	CreatedAt time.Time `json:"created_at" gorm:"index:idx_music_created"`
+}
+
+// TableName mengembalikan nama tabel
+func (PlayEvent) TableName() string {
+	return "play_events"
+}
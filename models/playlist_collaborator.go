@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+// PlaylistCollaborator model untuk user yang ikut mengelola playlist milik user lain
+type PlaylistCollaborator struct {
+	ID         uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	PlaylistID uint      `json:"playlist_id" gorm:"not null;index:idx_playlist_collaborator,unique"`
+	UserID     uint      `json:"user_id" gorm:"not null;index:idx_playlist_collaborator,unique;index"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// Relations (tanpa foreign key constraint untuk menghindari error migration)
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID;constraint:-"`
+}
+
+// TableName mengembalikan nama tabel
+func (PlaylistCollaborator) TableName() string {
+	return "playlist_collaborators"
+}
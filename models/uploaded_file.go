@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+// UploadedFile model untuk mencatat file audio/video yang diupload ke Firebase Storage.
+// Ukuran file pada music, music video dan cavelist diambil dari sini berdasarkan URL file.
+type UploadedFile struct {
+	ID          uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	FileURL     string    `json:"file_url" gorm:"size:500;not null;index"`
+	BucketPath  string    `json:"bucket_path" gorm:"size:500"`
+	FileSize    int64     `json:"file_size"`
+	ContentType string    `json:"content_type" gorm:"size:100"`
+	UploadedBy  *uint     `json:"uploaded_by" gorm:"index"` // User yang mengupload file
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName mengembalikan nama tabel
+func (UploadedFile) TableName() string {
+	return "uploaded_files"
+}
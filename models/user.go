@@ -66,6 +66,7 @@ type User struct {
 	Role          Role            `json:"role" gorm:"type:enum('user','admin','premium','independent','label');default:'user'"`
 	Followers     JSONStringArray `json:"followers" gorm:"type:json"`
 	TotalFollower int             `json:"total_follower" gorm:"default:0"`
+	TokenVersion  int             `json:"-" gorm:"default:0"` // Dinaikkan untuk mencabut semua token yang sudah diterbitkan
 	CreatedAt     time.Time       `json:"created_at"`
 	UpdatedAt     time.Time       `json:"updated_at"`
 	DeletedAt     gorm.DeletedAt  `json:"deleted_at" gorm:"index" swag:"-"`
@@ -12,6 +12,11 @@ import (
 
 // SetupRoutes mengatur semua routes aplikasi
 func SetupRoutes(app *fiber.App, db *gorm.DB, firebaseApp *firebase.App) {
+	// requestDB mengikat koneksi database ke context request agar query ikut dibatalkan saat timeout
+	requestDB := func(c *fiber.Ctx) *gorm.DB {
+		return db.WithContext(c.UserContext())
+	}
+
 	// Health check
 	app.Get("/", func(c *fiber.Ctx) error {
 		return c.JSON(fiber.Map{
@@ -27,10 +32,10 @@ func SetupRoutes(app *fiber.App, db *gorm.DB, firebaseApp *firebase.App) {
 	// SRS Webhook routes - registered directly on app to bypass all middleware
 	// These are called by SRS media server, no auth required
 	app.Post("/api/srs/on_publish", func(c *fiber.Ctx) error {
-		return handlers.OnPublishHandler(c, db)
+		return handlers.OnPublishHandler(c, requestDB(c))
 	})
 	app.Post("/api/srs/on_unpublish", func(c *fiber.Ctx) error {
-		return handlers.OnUnpublishHandler(c, db)
+		return handlers.OnUnpublishHandler(c, requestDB(c))
 	})
 
 	// API routes
@@ -39,394 +44,487 @@ func SetupRoutes(app *fiber.App, db *gorm.DB, firebaseApp *firebase.App) {
 	// Auth routes (public)
 	auth := api.Group("/auth")
 	auth.Post("/register", func(c *fiber.Ctx) error {
-		return handlers.RegisterHandler(c, db)
+		return handlers.RegisterHandler(c, requestDB(c))
 	})
 	auth.Post("/login", func(c *fiber.Ctx) error {
-		return handlers.LoginHandler(c, db)
+		return handlers.LoginHandler(c, requestDB(c))
 	})
 	auth.Post("/google", func(c *fiber.Ctx) error {
-		return handlers.GoogleAuthHandler(c, db)
+		return handlers.GoogleAuthHandler(c, requestDB(c))
 	})
 
 	// Protected routes (require authentication)
-	protected := api.Group("", middleware.AuthMiddleware)
+	protected := api.Group("", middleware.AuthMiddleware(db))
 	protected.Get("/profile", func(c *fiber.Ctx) error {
-		return handlers.GetProfileHandler(c, db)
+		return handlers.GetProfileHandler(c, requestDB(c))
+	})
+	protected.Delete("/profile/playlists", func(c *fiber.Ctx) error {
+		return handlers.DeleteMyPlaylistsHandler(c, requestDB(c))
 	})
 	protected.Get("/dashboard/stats", func(c *fiber.Ctx) error {
-		return handlers.GetDashboardStatsHandler(c, db)
+		return handlers.GetDashboardStatsHandler(c, requestDB(c))
 	})
 	protected.Get("/dashboard/customer-report", func(c *fiber.Ctx) error {
-		return handlers.GetCustomerReportHandler(c, db)
+		return handlers.GetCustomerReportHandler(c, requestDB(c))
 	})
 	protected.Get("/dashboard/artist-stats", func(c *fiber.Ctx) error {
-		return handlers.GetArtistDashboardStatsHandler(c, db)
+		return handlers.GetArtistDashboardStatsHandler(c, requestDB(c))
+	})
+	protected.Get("/dashboard/listener-demographics", func(c *fiber.Ctx) error {
+		return handlers.GetListenerDemographicsHandler(c, requestDB(c))
+	})
+	protected.Get("/dashboard/storage-usage", func(c *fiber.Ctx) error {
+		return handlers.GetStorageUsageHandler(c, requestDB(c))
 	})
 
 	// Image upload routes (Public for viewing, but maybe should be protected? Keeping as is for now unless asked)
 	images := api.Group("/images")
-	images.Post("/upload", middleware.AuthMiddleware, func(c *fiber.Ctx) error {
-		return handlers.UploadImageHandler(c, db)
+	images.Post("/upload", middleware.AuthMiddleware(db), func(c *fiber.Ctx) error {
+		return handlers.UploadImageHandler(c, requestDB(c))
 	})
-	images.Post("/upload-multiple", middleware.AuthMiddleware, func(c *fiber.Ctx) error {
-		return handlers.UploadMultipleImagesHandler(c, db)
+	images.Post("/upload-multiple", middleware.AuthMiddleware(db), func(c *fiber.Ctx) error {
+		return handlers.UploadMultipleImagesHandler(c, requestDB(c))
 	})
 	images.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetImagesHandler(c, db)
+		return handlers.GetImagesHandler(c, requestDB(c))
 	})
-	images.Delete("/:id", middleware.AuthMiddleware, func(c *fiber.Ctx) error {
-		return handlers.DeleteImageHandler(c, db)
+	images.Delete("/:id", middleware.AuthMiddleware(db), func(c *fiber.Ctx) error {
+		return handlers.DeleteImageHandler(c, requestDB(c))
 	})
 
 	// User CRUD routes (Protected)
-	users := api.Group("/users", middleware.AuthMiddleware)
+	users := api.Group("/users", middleware.AuthMiddleware(db))
 	users.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateUserHandler(c, db)
+		return handlers.CreateUserHandler(c, requestDB(c))
 	})
 	users.Post("/follow", func(c *fiber.Ctx) error {
-		return handlers.FollowUserHandler(c, db)
+		return handlers.FollowUserHandler(c, requestDB(c))
 	})
 	users.Post("/unfollow", func(c *fiber.Ctx) error {
-		return handlers.UnfollowUserHandler(c, db)
+		return handlers.UnfollowUserHandler(c, requestDB(c))
 	})
 	users.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetUsersHandler(c, db)
+		return handlers.GetUsersHandler(c, requestDB(c))
 	})
 	users.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetUserHandler(c, db)
+		return handlers.GetUserHandler(c, requestDB(c))
 	})
 	users.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateUserHandler(c, db)
+		return handlers.UpdateUserHandler(c, requestDB(c))
 	})
 	users.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteUserHandler(c, db)
+		return handlers.DeleteUserHandler(c, requestDB(c))
 	})
 
 	// Album CRUD routes (Protected)
-	albums := api.Group("/albums", middleware.AuthMiddleware)
+	albums := api.Group("/albums", middleware.AuthMiddleware(db))
 	albums.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateAlbumHandler(c, db)
+		return handlers.CreateAlbumHandler(c, requestDB(c))
 	})
 	albums.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetAlbumsHandler(c, db)
+		return handlers.GetAlbumsHandler(c, requestDB(c))
 	})
 	albums.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetAlbumHandler(c, db)
+		return handlers.GetAlbumHandler(c, requestDB(c))
 	})
 	albums.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateAlbumHandler(c, db)
+		return handlers.UpdateAlbumHandler(c, requestDB(c))
 	})
 	albums.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteAlbumHandler(c, db)
+		return handlers.DeleteAlbumHandler(c, requestDB(c))
 	})
 
 	// App Info CRUD routes (Protected)
-	appInfo := api.Group("/app-info", middleware.AuthMiddleware)
+	appInfo := api.Group("/app-info", middleware.AuthMiddleware(db))
 	appInfo.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateAppInfoHandler(c, db)
+		return handlers.CreateAppInfoHandler(c, requestDB(c))
 	})
 	appInfo.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetAppInfosHandler(c, db)
+		return handlers.GetAppInfosHandler(c, requestDB(c))
 	})
 	appInfo.Get("/latest", func(c *fiber.Ctx) error {
-		return handlers.GetLatestAppInfoHandler(c, db)
+		return handlers.GetLatestAppInfoHandler(c, requestDB(c))
 	})
 	appInfo.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetAppInfoHandler(c, db)
+		return handlers.GetAppInfoHandler(c, requestDB(c))
 	})
 	appInfo.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateAppInfoHandler(c, db)
+		return handlers.UpdateAppInfoHandler(c, requestDB(c))
 	})
 	appInfo.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteAppInfoHandler(c, db)
+		return handlers.DeleteAppInfoHandler(c, requestDB(c))
 	})
 
 	// Artist CRUD routes (Protected)
-	artists := api.Group("/artists", middleware.AuthMiddleware)
+	artists := api.Group("/artists", middleware.AuthMiddleware(db))
 	artists.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateArtistHandler(c, db)
+		return handlers.CreateArtistHandler(c, requestDB(c))
+	})
+	artists.Post("/import", func(c *fiber.Ctx) error {
+		return handlers.ImportArtistsHandler(c, requestDB(c))
 	})
 	artists.Get("/random", func(c *fiber.Ctx) error {
-		return handlers.GetRandomArtistsHandler(c, db)
+		return handlers.GetRandomArtistsHandler(c, requestDB(c))
 	})
 	artists.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetArtistsHandler(c, db)
+		return handlers.GetArtistsHandler(c, requestDB(c))
 	})
 	artists.Post("/:id/follow", func(c *fiber.Ctx) error {
-		return handlers.FollowArtistHandler(c, db)
+		return handlers.FollowArtistHandler(c, requestDB(c))
 	})
 	artists.Post("/:id/unfollow", func(c *fiber.Ctx) error {
-		return handlers.UnfollowArtistHandler(c, db)
+		return handlers.UnfollowArtistHandler(c, requestDB(c))
 	})
 	artists.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetArtistHandler(c, db)
+		return handlers.GetArtistHandler(c, requestDB(c))
+	})
+	artists.Get("/:id/musics", func(c *fiber.Ctx) error {
+		return handlers.GetArtistMusicsHandler(c, requestDB(c))
+	})
+	artists.Get("/:id/release-years", func(c *fiber.Ctx) error {
+		return handlers.GetArtistReleaseYearsHandler(c, requestDB(c))
 	})
 	artists.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateArtistHandler(c, db)
+		return handlers.UpdateArtistHandler(c, requestDB(c))
 	})
 	artists.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteArtistHandler(c, db)
+		return handlers.DeleteArtistHandler(c, requestDB(c))
 	})
 	artists.Put("/:id/highlight", func(c *fiber.Ctx) error {
-		return handlers.HighlightArtistHandler(c, db)
+		return handlers.HighlightArtistHandler(c, requestDB(c))
+	})
+	artists.Put("/:id/pin", func(c *fiber.Ctx) error {
+		return handlers.PinArtistMusicHandler(c, requestDB(c))
+	})
+	artists.Delete("/:id/pin", func(c *fiber.Ctx) error {
+		return handlers.UnpinArtistMusicHandler(c, requestDB(c))
 	})
 
 	// Genre CRUD routes (Protected)
-	genres := api.Group("/genres", middleware.AuthMiddleware)
+	genres := api.Group("/genres", middleware.AuthMiddleware(db))
 	genres.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateGenreHandler(c, db)
+		return handlers.CreateGenreHandler(c, requestDB(c))
 	})
 	genres.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetGenresHandler(c, db)
+		return handlers.GetGenresHandler(c, requestDB(c))
 	})
 	genres.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetGenreHandler(c, db)
+		return handlers.GetGenreHandler(c, requestDB(c))
 	})
 	genres.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateGenreHandler(c, db)
+		return handlers.UpdateGenreHandler(c, requestDB(c))
 	})
 	genres.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteGenreHandler(c, db)
+		return handlers.DeleteGenreHandler(c, requestDB(c))
 	})
 
 	// Music CRUD routes (Protected)
-	musics := api.Group("/musics", middleware.AuthMiddleware)
+	musics := api.Group("/musics", middleware.AuthMiddleware(db))
 	musics.Post("/upload", func(c *fiber.Ctx) error {
-		return handlers.UploadMusicHandler(c, db)
+		return handlers.UploadMusicHandler(c, requestDB(c))
 	})
 	musics.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateMusicHandler(c, db)
+		return handlers.CreateMusicHandler(c, requestDB(c))
 	})
 	musics.Get("/top-streamed", func(c *fiber.Ctx) error {
-		return handlers.GetTop5MostStreamedHandler(c, db)
+		return handlers.GetTop5MostStreamedHandler(c, requestDB(c))
+	})
+	musics.Get("/top-by-popularity", func(c *fiber.Ctx) error {
+		return handlers.GetTopMusicsByPopularityHandler(c, requestDB(c))
 	})
 	musics.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetMusicsHandler(c, db)
+		return handlers.GetMusicsHandler(c, requestDB(c))
 	})
 	musics.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetMusicHandler(c, db)
+		return handlers.GetMusicHandler(c, requestDB(c))
+	})
+	musics.Get("/:id/versions", func(c *fiber.Ctx) error {
+		return handlers.GetMusicVersionsHandler(c, requestDB(c))
 	})
 	musics.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateMusicHandler(c, db)
+		return handlers.UpdateMusicHandler(c, requestDB(c))
 	})
 	musics.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteMusicHandler(c, db)
+		return handlers.DeleteMusicHandler(c, requestDB(c))
 	})
 	musics.Post("/:id/play", func(c *fiber.Ctx) error {
-		return handlers.IncrementPlayCountHandler(c, db)
+		return handlers.IncrementPlayCountHandler(c, requestDB(c))
 	})
 	musics.Post("/:id/like", func(c *fiber.Ctx) error {
-		return handlers.IncrementLikeCountHandler(c, db)
+		return handlers.IncrementLikeCountHandler(c, requestDB(c))
 	})
 	musics.Post("/:id/unlike", func(c *fiber.Ctx) error {
-		return handlers.DecrementLikeCountHandler(c, db)
+		return handlers.DecrementLikeCountHandler(c, requestDB(c))
 	})
 	musics.Post("/:id/stream", func(c *fiber.Ctx) error {
-		return handlers.IncrementMusicStreamHandler(c, db)
+		return handlers.IncrementMusicStreamHandler(c, requestDB(c))
 	})
 	musics.Put("/:id/approve", func(c *fiber.Ctx) error {
-		return handlers.ApproveMusicHandler(c, db)
+		return handlers.ApproveMusicHandler(c, requestDB(c))
 	})
 
 	// Music Video CRUD routes (Protected)
-	musicVideos := api.Group("/music-videos", middleware.AuthMiddleware)
+	musicVideos := api.Group("/music-videos", middleware.AuthMiddleware(db))
 	musicVideos.Post("/upload", func(c *fiber.Ctx) error {
-		return handlers.UploadMusicVideoHandler(c, db)
+		return handlers.UploadMusicVideoHandler(c, requestDB(c))
 	})
 	musicVideos.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateMusicVideoHandler(c, db)
+		return handlers.CreateMusicVideoHandler(c, requestDB(c))
 	})
 	musicVideos.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetMusicVideosHandler(c, db)
+		return handlers.GetMusicVideosHandler(c, requestDB(c))
 	})
 	musicVideos.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetMusicVideoHandler(c, db)
+		return handlers.GetMusicVideoHandler(c, requestDB(c))
 	})
 	musicVideos.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateMusicVideoHandler(c, db)
+		return handlers.UpdateMusicVideoHandler(c, requestDB(c))
 	})
 	musicVideos.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteMusicVideoHandler(c, db)
+		return handlers.DeleteMusicVideoHandler(c, requestDB(c))
 	})
 	musicVideos.Post("/:id/stream", func(c *fiber.Ctx) error {
-		return handlers.IncrementMusicVideoStreamHandler(c, db)
+		return handlers.IncrementMusicVideoStreamHandler(c, requestDB(c))
 	})
 	musicVideos.Put("/:id/approve", func(c *fiber.Ctx) error {
-		return handlers.ApproveMusicVideoHandler(c, db)
+		return handlers.ApproveMusicVideoHandler(c, requestDB(c))
 	})
 
 	// Notification CRUD routes (Protected)
-	notifications := api.Group("/notifications", middleware.AuthMiddleware)
+	notifications := api.Group("/notifications", middleware.AuthMiddleware(db))
 	notifications.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateNotificationHandler(c, db)
+		return handlers.CreateNotificationHandler(c, requestDB(c))
 	})
 	notifications.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetNotificationsHandler(c, db)
+		return handlers.GetNotificationsHandler(c, requestDB(c))
+	})
+	notifications.Get("/preferences", func(c *fiber.Ctx) error {
+		return handlers.GetNotificationPreferencesHandler(c, requestDB(c))
+	})
+	notifications.Put("/preferences", func(c *fiber.Ctx) error {
+		return handlers.UpdateNotificationPreferencesHandler(c, requestDB(c))
 	})
 	notifications.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetNotificationHandler(c, db)
+		return handlers.GetNotificationHandler(c, requestDB(c))
 	})
 	notifications.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateNotificationHandler(c, db)
+		return handlers.UpdateNotificationHandler(c, requestDB(c))
 	})
 	notifications.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteNotificationHandler(c, db)
+		return handlers.DeleteNotificationHandler(c, requestDB(c))
 	})
 	notifications.Post("/:id/read", func(c *fiber.Ctx) error {
-		return handlers.MarkAsReadHandler(c, db)
+		return handlers.MarkAsReadHandler(c, requestDB(c))
 	})
 	notifications.Get("/user/:user_id", func(c *fiber.Ctx) error {
-		return handlers.GetUserNotificationsHandler(c, db)
+		return handlers.GetUserNotificationsHandler(c, requestDB(c))
+	})
+	notifications.Delete("/user/:user_id", func(c *fiber.Ctx) error {
+		return handlers.DeleteUserNotificationsHandler(c, requestDB(c))
 	})
 	notifications.Post("/user/:user_id/read-all", func(c *fiber.Ctx) error {
-		return handlers.MarkAllAsReadHandler(c, db)
+		return handlers.MarkAllAsReadHandler(c, requestDB(c))
+	})
+	notifications.Get("/user/:user_id/unread-by-type", func(c *fiber.Ctx) error {
+		return handlers.GetUnreadNotificationsByTypeHandler(c, requestDB(c))
 	})
 
 	// Playlist CRUD routes (Protected)
-	playlists := api.Group("/playlists", middleware.AuthMiddleware)
+	playlists := api.Group("/playlists", middleware.AuthMiddleware(db))
 	playlists.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreatePlaylistHandler(c, db)
+		return handlers.CreatePlaylistHandler(c, requestDB(c))
 	})
 	playlists.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetPlaylistsHandler(c, db)
+		return handlers.GetPlaylistsHandler(c, requestDB(c))
+	})
+	playlists.Get("/me", func(c *fiber.Ctx) error {
+		return handlers.GetMyPlaylistsHandler(c, requestDB(c))
 	})
 	playlists.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetPlaylistHandler(c, db)
+		return handlers.GetPlaylistHandler(c, requestDB(c))
 	})
 	playlists.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdatePlaylistHandler(c, db)
+		return handlers.UpdatePlaylistHandler(c, requestDB(c))
 	})
 	playlists.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeletePlaylistHandler(c, db)
+		return handlers.DeletePlaylistHandler(c, requestDB(c))
+	})
+	playlists.Post("/:id/transfer", func(c *fiber.Ctx) error {
+		return handlers.TransferPlaylistHandler(c, requestDB(c))
+	})
+	playlists.Get("/:id/collaborators", func(c *fiber.Ctx) error {
+		return handlers.GetPlaylistCollaboratorsHandler(c, requestDB(c))
+	})
+	playlists.Post("/:id/collaborators", func(c *fiber.Ctx) error {
+		return handlers.AddPlaylistCollaboratorHandler(c, requestDB(c))
+	})
+	playlists.Delete("/:id/collaborators/:user_id", func(c *fiber.Ctx) error {
+		return handlers.RemovePlaylistCollaboratorHandler(c, requestDB(c))
 	})
 
 	// Playlist Songs CRUD routes (Protected)
-	playlistSongs := api.Group("/playlist-songs", middleware.AuthMiddleware)
+	playlistSongs := api.Group("/playlist-songs", middleware.AuthMiddleware(db))
 	playlistSongs.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreatePlaylistSongHandler(c, db)
+		return handlers.CreatePlaylistSongHandler(c, requestDB(c))
 	})
 	playlistSongs.Get("/playlist/:playlist_id", func(c *fiber.Ctx) error {
-		return handlers.GetPlaylistSongsHandler(c, db)
+		return handlers.GetPlaylistSongsHandler(c, requestDB(c))
 	})
 	playlistSongs.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetPlaylistSongHandler(c, db)
+		return handlers.GetPlaylistSongHandler(c, requestDB(c))
 	})
 	playlistSongs.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdatePlaylistSongHandler(c, db)
+		return handlers.UpdatePlaylistSongHandler(c, requestDB(c))
 	})
 	playlistSongs.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeletePlaylistSongHandler(c, db)
+		return handlers.DeletePlaylistSongHandler(c, requestDB(c))
 	})
 	playlistSongs.Delete("/playlist/:playlist_id/music/:music_id", func(c *fiber.Ctx) error {
-		return handlers.DeletePlaylistSongByMusicHandler(c, db)
+		return handlers.DeletePlaylistSongByMusicHandler(c, requestDB(c))
 	})
 
 	// Podcast CRUD routes (Protected)
-	podcasts := api.Group("/podcasts", middleware.AuthMiddleware)
+	podcasts := api.Group("/podcasts", middleware.AuthMiddleware(db))
 	podcasts.Post("/upload", func(c *fiber.Ctx) error {
-		return handlers.UploadPodcastVideoHandler(c, db)
+		return handlers.UploadPodcastVideoHandler(c, requestDB(c))
 	})
 	podcasts.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreatePodcastHandler(c, db)
+		return handlers.CreatePodcastHandler(c, requestDB(c))
 	})
 	podcasts.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetPodcastsHandler(c, db)
+		return handlers.GetPodcastsHandler(c, requestDB(c))
 	})
 	podcasts.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetPodcastHandler(c, db)
+		return handlers.GetPodcastHandler(c, requestDB(c))
+	})
+	podcasts.Get("/:id/next", func(c *fiber.Ctx) error {
+		return handlers.GetNextPodcastHandler(c, requestDB(c))
 	})
 	podcasts.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdatePodcastHandler(c, db)
+		return handlers.UpdatePodcastHandler(c, requestDB(c))
 	})
 	podcasts.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeletePodcastHandler(c, db)
+		return handlers.DeletePodcastHandler(c, requestDB(c))
 	})
 	podcasts.Post("/:id/stream", func(c *fiber.Ctx) error {
-		return handlers.IncrementPodcastStreamHandler(c, db)
+		return handlers.IncrementPodcastStreamHandler(c, requestDB(c))
 	})
 
 	// Subscription Plan CRUD routes (Protected)
-	subscriptionPlans := api.Group("/subscription-plans", middleware.AuthMiddleware)
+	subscriptionPlans := api.Group("/subscription-plans", middleware.AuthMiddleware(db))
 	subscriptionPlans.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateSubscriptionPlanHandler(c, db)
+		return handlers.CreateSubscriptionPlanHandler(c, requestDB(c))
 	})
 	subscriptionPlans.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetSubscriptionPlansHandler(c, db)
+		return handlers.GetSubscriptionPlansHandler(c, requestDB(c))
 	})
 	subscriptionPlans.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetSubscriptionPlanHandler(c, db)
+		return handlers.GetSubscriptionPlanHandler(c, requestDB(c))
 	})
 	subscriptionPlans.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateSubscriptionPlanHandler(c, db)
+		return handlers.UpdateSubscriptionPlanHandler(c, requestDB(c))
 	})
 	subscriptionPlans.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteSubscriptionPlanHandler(c, db)
+		return handlers.DeleteSubscriptionPlanHandler(c, requestDB(c))
 	})
 
 	// News CRUD routes (Protected)
-	news := api.Group("/news", middleware.AuthMiddleware)
+	news := api.Group("/news", middleware.AuthMiddleware(db))
 	news.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateNewsHandler(c, db)
+		return handlers.CreateNewsHandler(c, requestDB(c))
 	})
 	news.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetNewsHandler(c, db)
+		return handlers.GetNewsHandler(c, requestDB(c))
 	})
 	news.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetNewsByIDHandler(c, db)
+		return handlers.GetNewsByIDHandler(c, requestDB(c))
 	})
 	news.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateNewsHandler(c, db)
+		return handlers.UpdateNewsHandler(c, requestDB(c))
 	})
 	news.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteNewsHandler(c, db)
+		return handlers.DeleteNewsHandler(c, requestDB(c))
 	})
 
 	// Cavelist CRUD routes (Protected)
-	cavelists := api.Group("/cavelists", middleware.AuthMiddleware)
+	cavelists := api.Group("/cavelists", middleware.AuthMiddleware(db))
 	cavelists.Post("/upload", func(c *fiber.Ctx) error {
-		return handlers.UploadCavelistVideoHandler(c, db)
+		return handlers.UploadCavelistVideoHandler(c, requestDB(c))
 	})
 	cavelists.Post("/", func(c *fiber.Ctx) error {
-		return handlers.CreateCavelistHandler(c, db)
+		return handlers.CreateCavelistHandler(c, requestDB(c))
 	})
 	cavelists.Get("/", func(c *fiber.Ctx) error {
-		return handlers.GetCavelistsHandler(c, db)
+		return handlers.GetCavelistsHandler(c, requestDB(c))
 	})
 	cavelists.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetCavelistByIDHandler(c, db)
+		return handlers.GetCavelistByIDHandler(c, requestDB(c))
 	})
 	cavelists.Put("/:id", func(c *fiber.Ctx) error {
-		return handlers.UpdateCavelistHandler(c, db)
+		return handlers.UpdateCavelistHandler(c, requestDB(c))
 	})
 	cavelists.Delete("/:id", func(c *fiber.Ctx) error {
-		return handlers.DeleteCavelistHandler(c, db)
+		return handlers.DeleteCavelistHandler(c, requestDB(c))
 	})
 	cavelists.Post("/:id/like", func(c *fiber.Ctx) error {
-		return handlers.IncrementCavelistLikesHandler(c, db)
+		return handlers.IncrementCavelistLikesHandler(c, requestDB(c))
 	})
 	cavelists.Post("/:id/share", func(c *fiber.Ctx) error {
-		return handlers.IncrementCavelistSharesHandler(c, db)
+		return handlers.IncrementCavelistSharesHandler(c, requestDB(c))
+	})
+	cavelists.Get("/:id/stats", func(c *fiber.Ctx) error {
+		return handlers.GetCavelistStatsHandler(c, requestDB(c))
 	})
 
 	// Artist Stream routes (Protected)
-	artistStreams := api.Group("/artist-streams", middleware.AuthMiddleware)
+	artistStreams := api.Group("/artist-streams", middleware.AuthMiddleware(db))
 	artistStreams.Post("/start", func(c *fiber.Ctx) error {
-		return handlers.StartStreamHandler(c, db)
+		return handlers.StartStreamHandler(c, requestDB(c))
 	})
 	artistStreams.Post("/:id/mark-live", func(c *fiber.Ctx) error {
-		return handlers.MarkStreamLiveHandler(c, db)
+		return handlers.MarkStreamLiveHandler(c, requestDB(c))
 	})
 	artistStreams.Post("/end/:id", func(c *fiber.Ctx) error {
-		return handlers.EndStreamHandler(c, db)
+		return handlers.EndStreamHandler(c, requestDB(c))
 	})
 	artistStreams.Get("/active", func(c *fiber.Ctx) error {
-		return handlers.GetActiveStreamsHandler(c, db)
+		return handlers.GetActiveStreamsHandler(c, requestDB(c))
 	})
 	artistStreams.Get("/history", func(c *fiber.Ctx) error {
-		return handlers.GetArtistStreamHistoryHandler(c, db)
+		return handlers.GetArtistStreamHistoryHandler(c, requestDB(c))
 	})
 	artistStreams.Get("/:id", func(c *fiber.Ctx) error {
-		return handlers.GetStreamDetailsHandler(c, db)
+		return handlers.GetStreamDetailsHandler(c, requestDB(c))
+	})
+
+	// Admin routes (Protected, admin only)
+	admin := api.Group("/admin", middleware.AuthMiddleware(db), middleware.AdminMiddleware)
+	admin.Get("/musics/duplicates", func(c *fiber.Ctx) error {
+		return handlers.GetDuplicateMusicsHandler(c, requestDB(c))
+	})
+	admin.Post("/musics/genres", func(c *fiber.Ctx) error {
+		return handlers.AssignMusicGenresHandler(c, requestDB(c))
+	})
+	admin.Post("/musics/:id/merge/:into_id", func(c *fiber.Ctx) error {
+		return handlers.MergeMusicHandler(c, requestDB(c))
+	})
+	admin.Post("/musics/:id/reset-counts", func(c *fiber.Ctx) error {
+		return handlers.ResetMusicCountsHandler(c, requestDB(c))
+	})
+	admin.Put("/musics/:id/editorial-weight", func(c *fiber.Ctx) error {
+		return handlers.UpdateMusicEditorialWeightHandler(c, requestDB(c))
+	})
+	admin.Put("/genres/:id/editorial-weight", func(c *fiber.Ctx) error {
+		return handlers.UpdateGenreEditorialWeightHandler(c, requestDB(c))
+	})
+	admin.Post("/users/:id/revoke-sessions", func(c *fiber.Ctx) error {
+		return handlers.RevokeUserSessionsHandler(c, requestDB(c))
+	})
+	admin.Get("/playlists/:id/integrity", func(c *fiber.Ctx) error {
+		return handlers.GetPlaylistIntegrityHandler(c, requestDB(c))
+	})
+	admin.Post("/playlists/:id/integrity/normalize", func(c *fiber.Ctx) error {
+		return handlers.NormalizePlaylistPositionsHandler(c, requestDB(c))
 	})
 
 }
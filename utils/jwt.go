@@ -1,14 +1,20 @@
 package utils
 
 import (
+	"backend_soundcave/models"
+	"errors"
 	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
 )
 
 var jwtSecret = []byte(getJWTSecret())
 
+// ErrTokenRevoked dikembalikan jika token diterbitkan sebelum sesi user dicabut
+var ErrTokenRevoked = errors.New("sesi sudah dicabut")
+
 // getJWTSecret mendapatkan JWT secret dari environment variable
 func getJWTSecret() string {
 	secret := os.Getenv("JWT_SECRET")
@@ -20,20 +26,22 @@ func getJWTSecret() string {
 
 // Claims struct untuk JWT token
 type Claims struct {
-	UserID uint   `json:"user_id"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
+	UserID       uint   `json:"user_id"`
+	Email        string `json:"email"`
+	Role         string `json:"role"`
+	TokenVersion int    `json:"token_version"` // Harus sama dengan users.token_version agar token diterima
 	jwt.RegisteredClaims
 }
 
 // GenerateToken menghasilkan JWT token
-func GenerateToken(userID uint, email, role string) (string, error) {
+func GenerateToken(userID uint, email, role string, tokenVersion int) (string, error) {
 	expirationTime := time.Now().Add(30 * 24 * time.Hour) // Token berlaku 30 hari
 
 	claims := &Claims{
-		UserID: userID,
-		Email:  email,
-		Role:   role,
+		UserID:       userID,
+		Email:        email,
+		Role:         role,
+		TokenVersion: tokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -68,3 +76,22 @@ func ValidateToken(tokenString string) (*Claims, error) {
 
 	return claims, nil
 }
+
+// ValidateSessionToken memvalidasi JWT token dan memastikan sesinya belum dicabut.
+// Token ditolak jika user sudah tidak ada atau token_version-nya berbeda dengan users.token_version.
+func ValidateSessionToken(db *gorm.DB, tokenString string) (*Claims, error) {
+	claims, err := ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.User
+	if err := db.Select("id", "token_version").First(&user, claims.UserID).Error; err != nil {
+		return nil, err
+	}
+	if user.TokenVersion != claims.TokenVersion {
+		return nil, ErrTokenRevoked
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"strings"
+)
+
+// countryAliases memetakan penulisan negara yang umum ke nama baku
+var countryAliases = map[string]string{
+	"id":                       "Indonesia",
+	"idn":                      "Indonesia",
+	"indonesia":                "Indonesia",
+	"ri":                       "Indonesia",
+	"my":                       "Malaysia",
+	"malaysia":                 "Malaysia",
+	"sg":                       "Singapore",
+	"singapore":                "Singapore",
+	"singapura":                "Singapore",
+	"us":                       "United States",
+	"usa":                      "United States",
+	"united states":            "United States",
+	"united states of america": "United States",
+	"amerika serikat":          "United States",
+	"uk":                       "United Kingdom",
+	"gb":                       "United Kingdom",
+	"united kingdom":           "United Kingdom",
+	"inggris":                  "United Kingdom",
+}
+
+// NormalizeCountry mengambil negara dari location user (bagian terakhir setelah koma).
+// Mengembalikan "Unknown" jika location kosong.
+func NormalizeCountry(location string) string {
+	parts := strings.Split(location, ",")
+	country := strings.TrimSpace(parts[len(parts)-1])
+	if country == "" {
+		return "Unknown"
+	}
+
+	if alias, ok := countryAliases[strings.ToLower(country)]; ok {
+		return alias
+	}
+
+	// Samakan kapitalisasi agar "japan" dan "JAPAN" dihitung sebagai satu negara
+	words := strings.Fields(strings.ToLower(country))
+	for i, word := range words {
+		runes := []rune(word)
+		words[i] = strings.ToUpper(string(runes[0])) + string(runes[1:])
+	}
+	return strings.Join(words, " ")
+}
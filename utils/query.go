@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseOrder memvalidasi arah sorting dari query parameter "order".
+// Nilai kosong menggunakan defaultOrder milik endpoint, nilai selain asc/desc menghasilkan error.
+func ParseOrder(order, defaultOrder string) (string, error) {
+	order = strings.ToLower(strings.TrimSpace(order))
+	if order == "" {
+		return defaultOrder, nil
+	}
+
+	if order != "asc" && order != "desc" {
+		return "", fmt.Errorf("order tidak valid. Pilih: asc atau desc")
+	}
+
+	return order, nil
+}
+
+// NormalizePagination memastikan page minimal 1 dan limit berada di antara 1 dan maxLimit
+func NormalizePagination(page, limit, maxLimit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 1
+	}
+	if maxLimit > 0 && limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return page, limit
+}
+
+// ParseExpand memecah query parameter "expand" (dipisah koma) dan memvalidasi setiap nilai terhadap allowed
+func ParseExpand(expand string, allowed ...string) (map[string]bool, error) {
+	result := make(map[string]bool)
+	for _, value := range strings.Split(expand, ",") {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" {
+			continue
+		}
+
+		valid := false
+		for _, a := range allowed {
+			if value == a {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("expand tidak valid: %s. Pilih: %s", value, strings.Join(allowed, ", "))
+		}
+
+		result[value] = true
+	}
+
+	return result, nil
+}
+
+// TruncateText memotong teks menjadi maksimal maxLength karakter dan menambahkan "..." jika terpotong
+func TruncateText(text string, maxLength int) string {
+	runes := []rune(text)
+	if maxLength <= 0 || len(runes) <= maxLength {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:maxLength])) + "..."
+}
@@ -0,0 +1,37 @@
+package utils
+
+import "testing"
+
+func TestParseOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		order        string
+		defaultOrder string
+		want         string
+		wantErr      bool
+	}{
+		{name: "empty uses desc default", order: "", defaultOrder: "desc", want: "desc"},
+		{name: "empty uses asc default", order: "", defaultOrder: "asc", want: "asc"},
+		{name: "explicit value overrides default", order: "asc", defaultOrder: "desc", want: "asc"},
+		{name: "case and whitespace are normalized", order: " DESC ", defaultOrder: "asc", want: "desc"},
+		{name: "invalid value errors", order: "sideways", defaultOrder: "desc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOrder(tt.order, tt.defaultOrder)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseOrder(%q) = %q, want error", tt.order, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseOrder(%q) error = %v", tt.order, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseOrder(%q, %q) = %q, want %q", tt.order, tt.defaultOrder, got, tt.want)
+			}
+		})
+	}
+}